@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/orphans"
+	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/requests"
+	"terraform-provider-vtb/pkg/client/sources"
+)
+
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// vtb-orphans выводит заказы проекта, которые не управляются terraform
+func main() {
+	var (
+		projectName, clientID, clientSecret, managed string
+		statePaths                                   stringList
+		jsonOutput                                   bool
+	)
+
+	flag.StringVar(&projectName, "project", os.Getenv("PROJECT_NAME"), "project name (PROJECT_NAME)")
+	flag.StringVar(&clientID, "client-id", os.Getenv("CLIENT_ID"), "service account client id (CLIENT_ID)")
+	flag.StringVar(&clientSecret, "client-secret", os.Getenv("CLIENT_SECRET"), "service account secret (CLIENT_SECRET)")
+	flag.Var(&statePaths, "state", "path to terraform.tfstate, can be repeated")
+	flag.StringVar(&managed, "managed", "", "comma separated list of managed order ids")
+	flag.BoolVar(&jsonOutput, "json", false, "print report as json")
+	flag.Parse()
+
+	if projectName == "" || clientID == "" || clientSecret == "" {
+		flag.Usage()
+		log.Fatal("project, client-id and client-secret are required")
+	}
+	if len(statePaths) == 0 && managed == "" {
+		flag.Usage()
+		log.Fatal("state or managed is required")
+	}
+
+	var managedIDs []string
+	for _, id := range strings.Split(managed, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			managedIDs = append(managedIDs, id)
+		}
+	}
+	for _, statePath := range statePaths {
+		ids, err := orphans.ManagedOrderIDsFromState(statePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		managedIDs = append(managedIDs, ids...)
+	}
+
+	if err := requests.EnvURLError(); err != nil {
+		log.Fatalf("Invalid PORTAL_API_URL or PORTAL_AUTH_URL: %v", err)
+	}
+
+	creds, err := auth.NewCredentials(clientID, clientSecret)
+	if err != nil {
+		log.Fatalf("Can't get access token for authorization: %v", err)
+	}
+
+	project, err := sources.GetProject(creds, projectName)
+	if err != nil {
+		log.Fatalf("Get project data from portal: %v", err)
+	}
+
+	unmanaged, err := orphans.FindUnmanagedOrders(client.NewCloudClient(creds, project), managedIDs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if jsonOutput {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(unmanaged); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Printf("Unmanaged orders in project %s: %d\n\n", projectName, len(unmanaged))
+	if len(unmanaged) == 0 {
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ORDER ID\tPRODUCT\tLABEL\tCREATED\tEXPIRES\tFINANCIAL PROJECT")
+	for _, order := range unmanaged {
+		expires := "never"
+		if order.ExpiresAt != nil {
+			expires = order.ExpiresAt.Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			order.ID, order.Product, order.Label, order.CreatedAt, expires, order.FinancialProject,
+		)
+	}
+	w.Flush()
+}
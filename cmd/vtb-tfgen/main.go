@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/tfgen"
+	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/requests"
+	"terraform-provider-vtb/pkg/client/sources"
+)
+
+// vtb-tfgen генерирует конфигурацию terraform и блоки import
+// для существующих заказов проекта
+func main() {
+	var projectName, clientID, clientSecret, out string
+
+	flag.StringVar(&projectName, "project", os.Getenv("PROJECT_NAME"), "project name (PROJECT_NAME)")
+	flag.StringVar(&clientID, "client-id", os.Getenv("CLIENT_ID"), "service account client id (CLIENT_ID)")
+	flag.StringVar(&clientSecret, "client-secret", os.Getenv("CLIENT_SECRET"), "service account secret (CLIENT_SECRET)")
+	flag.StringVar(&out, "out", "generated", "output directory")
+	flag.Parse()
+
+	if projectName == "" || clientID == "" || clientSecret == "" {
+		flag.Usage()
+		log.Fatal("project, client-id and client-secret are required")
+	}
+
+	if err := requests.EnvURLError(); err != nil {
+		log.Fatalf("Invalid PORTAL_API_URL or PORTAL_AUTH_URL: %v", err)
+	}
+
+	creds, err := auth.NewCredentials(clientID, clientSecret)
+	if err != nil {
+		log.Fatalf("Can't get access token for authorization: %v", err)
+	}
+
+	project, err := sources.GetProject(creds, projectName)
+	if err != nil {
+		log.Fatalf("Get project data from portal: %v", err)
+	}
+
+	generator := tfgen.NewGenerator(client.NewCloudClient(creds, project))
+	result, err := generator.Generate(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tfgen.WriteFiles(out, result.Resources)
+	if err != nil {
+		log.Fatalf("Write generated files: %v", err)
+	}
+
+	fmt.Printf("Generated %d resources into %s\n", len(result.Resources), out)
+	for _, r := range result.Resources {
+		fmt.Printf("  %s.%s (order %s)\n", r.Type, r.Name, r.OrderID)
+	}
+
+	if len(result.Unsupported) > 0 {
+		fmt.Printf("\nUnsupported orders: %d\n", len(result.Unsupported))
+		for _, s := range result.Unsupported {
+			fmt.Printf("  %s (label='%s'): %s\n", s.OrderID, s.Label, s.Reason)
+		}
+	}
+
+	if len(result.Failed) > 0 {
+		fmt.Printf("\nFailed orders: %d\n", len(result.Failed))
+		for _, s := range result.Failed {
+			fmt.Printf("  %s (label='%s'): %s\n", s.OrderID, s.Label, s.Reason)
+		}
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,5 @@
+data "vtb_access_group_purposes" "all" {}
+
+output "purposes" {
+	value = data.vtb_access_group_purposes.all.codes
+}
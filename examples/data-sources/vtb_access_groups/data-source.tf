@@ -0,0 +1,13 @@
+data "vtb_access_groups" "admins" {
+	domain        = "corp.dev.vtb"
+	purpose       = "compute"
+	accounts_type = "personal"
+	name_regex    = "-admins$"
+}
+
+resource "vtb_compute_instance" "example" {
+	# ...
+	access = {
+		"superuser" = data.vtb_access_groups.admins.names
+	}
+}
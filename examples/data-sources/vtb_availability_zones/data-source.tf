@@ -0,0 +1,11 @@
+data "vtb_availability_zones" "dev" {
+	net_segment         = "dev-srv-app"
+	current_environment = true
+}
+
+data "vtb_core_data" "dev" {
+	net_segment = "dev-srv-app"
+	zone        = data.vtb_availability_zones.dev.codes[0]
+	domain      = "corp.dev.vtb"
+	platform    = "OpenStack"
+}
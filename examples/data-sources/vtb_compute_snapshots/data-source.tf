@@ -0,0 +1,7 @@
+data "vtb_compute_snapshots" "app" {
+  vm_order_id = vtb_compute_instance.app.order_id
+}
+
+output "snapshot_names" {
+  value = data.vtb_compute_snapshots.app.names
+}
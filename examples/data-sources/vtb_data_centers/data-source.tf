@@ -0,0 +1,3 @@
+data "vtb_data_centers" "dev" {
+	net_segment = "dev-srv-app"
+}
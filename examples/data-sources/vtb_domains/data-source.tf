@@ -0,0 +1,3 @@
+data "vtb_domains" "dev" {
+	net_segment = "dev-srv-app"
+}
@@ -0,0 +1,3 @@
+data "vtb_financial_project" "default" {
+	name = "Для тестовых целей"
+}
@@ -0,0 +1,5 @@
+data "vtb_financial_projects" "all" {}
+
+output "financial_projects" {
+	value = data.vtb_financial_projects.all.names
+}
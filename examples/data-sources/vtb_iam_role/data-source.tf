@@ -0,0 +1,3 @@
+data "vtb_iam_role" "accounts_viewer" {
+  name = "accounts-viewer"
+}
@@ -0,0 +1,5 @@
+data "vtb_iam_service_roles" "project" {}
+
+output "service_roles" {
+  value = [for role in data.vtb_iam_service_roles.project.roles : role.name]
+}
@@ -0,0 +1,23 @@
+data "vtb_kafka_catalog" "billing" {
+  file = "${path.module}/kafka-catalog.yaml"
+}
+
+resource "vtb_kafka_instance" "kafka" {
+  # ...
+  topics = data.vtb_kafka_catalog.billing.topics
+  acls   = data.vtb_kafka_catalog.billing.acls
+}
+
+# или отдельными ресурсами
+resource "vtb_kafka_topic" "catalog" {
+  for_each = data.vtb_kafka_catalog.billing.topics
+
+  kafka_order_id   = vtb_kafka_instance.kafka.order_id
+  name             = each.key
+  cleanup_policy   = each.value.cleanup_policy
+  partitions       = each.value.partitions
+  segment_size_mb  = each.value.segment_size_mb
+  retention_ms     = each.value.retention_ms
+  retention_bytes  = each.value.retention_bytes
+  compression_type = each.value.compression_type
+}
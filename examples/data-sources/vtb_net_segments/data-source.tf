@@ -0,0 +1,3 @@
+data "vtb_net_segments" "dev" {
+	code_regex = "^dev-"
+}
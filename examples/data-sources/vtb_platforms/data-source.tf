@@ -0,0 +1,5 @@
+data "vtb_platforms" "dev" {
+	net_segment        = "dev-srv-app"
+	zone               = "msk-north"
+	exclude_restricted = true
+}
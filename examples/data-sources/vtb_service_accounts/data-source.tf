@@ -0,0 +1,5 @@
+data "vtb_service_accounts" "project" {}
+
+output "service_accounts" {
+  value = [for sa in data.vtb_service_accounts.project.service_accounts : sa.name]
+}
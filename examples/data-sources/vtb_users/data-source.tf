@@ -0,0 +1,18 @@
+data "vtb_users" "team" {
+	domain = "corp.dev.vtb"
+	queries = [
+		"ivanov@vtb.ru",
+		"petrov@vtb.ru",
+	]
+}
+
+resource "vtb_access_group_instance" "team" {
+	name        = "team-admins"
+	domain      = "corp.dev.vtb"
+	description = "Администраторы команды"
+	users = [
+		for user in data.vtb_users.team.users : {
+			unique_name = user.unique_name
+		}
+	]
+}
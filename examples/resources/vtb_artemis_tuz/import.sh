@@ -1 +1,2 @@
-terraform import vtb_artemis_tuz.test <vtb_artemis_order_id>
\ No newline at end of file
+terraform import vtb_artemis_tuz.test <vtb_artemis_order_id>
+terraform import vtb_artemis_tuz.test <vtb_artemis_order_id>/<user_name>
\ No newline at end of file
@@ -1 +1,3 @@
-tofu import vtb_compute_instance.name <order_id>
\ No newline at end of file
+tofu import vtb_compute_instance.name <order_id>
+tofu import vtb_compute_instance.name label:<label>
+tofu import vtb_compute_instance.name hostname:<fqdn>
\ No newline at end of file
@@ -32,4 +32,9 @@ resource "vtb_compute_instance" "name" {
       "cloud-soub-ssh",
     ],
   }
+
+  # "off" штатно выключает ВМ
+  power_state = "on"
+  # новое значение перезагружает ВМ, например после установки обновлений
+  reboot_trigger = "patch-2024-06"
 }
\ No newline at end of file
@@ -0,0 +1,3 @@
+tofu import vtb_iam_role.accounts_viewer <name>
+tofu import vtb_iam_role.accounts_viewer <organization>/<name>
+tofu import vtb_iam_role.accounts_viewer organizations/<organization>/roles/<name>
@@ -0,0 +1,9 @@
+resource "vtb_iam_role" "accounts_viewer" {
+  name        = "accounts-viewer"
+  title       = "Просмотр аккаунтов"
+  description = "Роль для чтения аккаунтов организации"
+  permissions = [
+    "accountmanager:accounts:get",
+    "accountmanager:accounts:list",
+  ]
+}
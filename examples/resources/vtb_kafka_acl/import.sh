@@ -0,0 +1 @@
+tofu import vtb_kafka_acl.billing <order_id>/<client_cn>
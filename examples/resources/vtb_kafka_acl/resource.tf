@@ -0,0 +1,13 @@
+resource "vtb_kafka_instance" "kafka" {
+  # ...
+  acls_ownership = "shared"
+}
+
+resource "vtb_kafka_acl" "billing" {
+  kafka_order_id        = vtb_kafka_instance.kafka.order_id
+  client_cn             = "APD-billing"
+  allow_idempotent      = true
+  consumer_by_name      = ["orders-events"]
+  producer_by_mask      = ["billing."]
+  transactional_by_name = ["billing-tx"]
+}
@@ -1 +1,3 @@
-tofu import vtb_kafka_instance.kafka_powere_test2 <order_id>
\ No newline at end of file
+tofu import vtb_kafka_instance.kafka_powere_test2 <order_id>
+tofu import vtb_kafka_instance.kafka_powere_test2 label:<label>
+tofu import vtb_kafka_instance.kafka_powere_test2 cluster_name:<cluster_name>
\ No newline at end of file
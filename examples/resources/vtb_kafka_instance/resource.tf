@@ -71,4 +71,7 @@ resource "vtb_kafka_instance" "kafka_powere_test2" {
       transactional_by_name = ["test-xxx"]
     }
   }
+
+  # "off" останавливает кластер, например на ночь для непромышленных сред
+  power_state = "on"
 }
@@ -0,0 +1,2 @@
+tofu import vtb_kafka_quota.billing <order_id>/<client_cn>
+tofu import vtb_kafka_quota.default <order_id>/default
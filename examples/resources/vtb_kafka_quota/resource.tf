@@ -0,0 +1,17 @@
+resource "vtb_kafka_instance" "kafka" {
+  # ...
+  quotas_ownership = "shared"
+}
+
+resource "vtb_kafka_quota" "default" {
+  kafka_order_id     = vtb_kafka_instance.kafka.order_id
+  type               = "default"
+  producer_byte_rate = 1048576
+}
+
+resource "vtb_kafka_quota" "billing" {
+  kafka_order_id     = vtb_kafka_instance.kafka.order_id
+  type               = "personal"
+  client_cn          = "APD-billing"
+  producer_byte_rate = 5242880
+}
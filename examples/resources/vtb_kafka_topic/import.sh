@@ -0,0 +1 @@
+tofu import vtb_kafka_topic.orders <order_id>/<topic>
@@ -0,0 +1,17 @@
+resource "vtb_kafka_instance" "kafka" {
+  # ...
+  topics_ownership = "shared"
+}
+
+resource "vtb_kafka_topic" "orders" {
+  kafka_order_id   = vtb_kafka_instance.kafka.order_id
+  name             = "orders-events"
+  cleanup_policy   = "delete"
+  partitions       = 3
+  retention_ms     = 86400000
+  segment_size_mb  = 512
+  compression_type = "zstd"
+
+  # уменьшение partitions удалит топик вместе с данными
+  allow_topic_recreate = false
+}
@@ -1 +1,2 @@
-tofu import vtb_rabbitmq_user.test_user <order_id>/<username>
\ No newline at end of file
+tofu import vtb_rabbitmq_user.test_user <order_id>/<username>
+tofu import vtb_rabbitmq_user.test_user cluster_name:<cluster_name>/<username>
\ No newline at end of file
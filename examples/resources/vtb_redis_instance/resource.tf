@@ -38,4 +38,5 @@ resource "vtb_redis_instance" "new_redis_demo_tf" {
   user                   = "demo"
   user_password          = "T3j9r8u4W9k6HfJ7q2V5lJd3R8B0pQ1M6X8ZpC5A1K9NwT2Xs5gV2L3Yt7sdawawawWvfv"
   notify_keyspace_events = "AKE"
+  power_state            = "on"
 }
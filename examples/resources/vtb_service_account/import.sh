@@ -0,0 +1,2 @@
+tofu import vtb_service_account.ci <name>
+tofu import vtb_service_account.ci <project>/<name>
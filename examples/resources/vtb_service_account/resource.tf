@@ -0,0 +1,14 @@
+resource "vtb_service_account" "ci" {
+  title            = "ci-deployer"
+  roles            = ["roles/admin"]
+  rotation_trigger = "2026-10-01"
+}
+
+output "ci_client_id" {
+  value = vtb_service_account.ci.client_id
+}
+
+output "ci_client_secret" {
+  value     = vtb_service_account.ci.client_secret
+  sensitive = true
+}
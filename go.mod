@@ -12,9 +12,13 @@ require (
 	github.com/hashicorp/terraform-plugin-log v0.9.0 // indirect
 	github.com/hashicorp/terraform-plugin-testing v1.5.1
 	github.com/joho/godotenv v1.5.1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
-require github.com/Masterminds/semver v1.5.0
+require (
+	github.com/Masterminds/semver v1.5.0
+	golang.org/x/exp v0.0.0-20230809150735-7b3493d9a819
+)
 
 require (
 	github.com/Masterminds/goutils v1.1.1 // indirect
@@ -68,7 +72,6 @@ require (
 	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 	github.com/zclconf/go-cty v1.13.3 // indirect
 	golang.org/x/crypto v0.12.0 // indirect
-	golang.org/x/exp v0.0.0-20230809150735-7b3493d9a819 // indirect
 	golang.org/x/mod v0.11.0 // indirect
 	golang.org/x/net v0.11.0 // indirect
 	golang.org/x/sys v0.11.0 // indirect
@@ -16,6 +16,10 @@ type CloudClient struct {
 	EnvPrefix       string
 	RisShortName    string
 	Creds           *auth.Credentials
+
+	// Окно обслуживания провайдера, используется если в ресурсе не задано свое
+	MaintenanceWindow  *MaintenanceWindow
+	ForceOutsideWindow bool
 }
 
 func NewCloudClient(creds *auth.Credentials, project *entities.Project) *CloudClient {
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"terraform-provider-vtb/internal/consts"
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// MaintenanceWindow окно обслуживания, в которое разрешены действия
+// с перезагрузкой ВМ или перезапуском кластера
+type MaintenanceWindow struct {
+	Days     []time.Weekday
+	Start    time.Duration // смещение начала окна от полуночи
+	Duration time.Duration
+	Location *time.Location
+}
+
+func ParseMaintenanceWindow(days []string, start, duration, timezone string) (*MaintenanceWindow, error) {
+
+	if len(days) == 0 {
+		return nil, fmt.Errorf("maintenance window must contain at least one day")
+	}
+
+	window := &MaintenanceWindow{}
+	for _, day := range days {
+		weekday, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return nil, fmt.Errorf(
+				"unknown maintenance window day '%s', available: %s",
+				day, strings.Join(consts.MAINTENANCE_WINDOW_DAYS, ", "),
+			)
+		}
+		window.Days = append(window.Days, weekday)
+	}
+
+	startTime, err := time.Parse("15:04", start)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance window start must be in format HH:MM, got '%s'", start)
+	}
+	window.Start = time.Duration(startTime.Hour())*time.Hour + time.Duration(startTime.Minute())*time.Minute
+
+	window.Duration, err = time.ParseDuration(duration)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance window duration must be like '4h' or '90m', got '%s'", duration)
+	}
+	if window.Duration <= 0 || window.Duration > 7*24*time.Hour {
+		return nil, fmt.Errorf("maintenance window duration must be between 1m and 168h, got '%s'", duration)
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	window.Location, err = time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown maintenance window timezone '%s': %v", timezone, err)
+	}
+	return window, nil
+}
+
+// Contains проверяет, что момент t попадает в окно обслуживания.
+// Окно может переходить через полночь, поэтому проверяются и окна,
+// начавшиеся в предыдущие дни
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	local := t.In(w.Location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, w.Location)
+
+	for offset := 0; offset <= 7; offset++ {
+		day := midnight.AddDate(0, 0, -offset)
+		if !w.hasDay(day.Weekday()) {
+			continue
+		}
+		start := day.Add(w.Start)
+		end := start.Add(w.Duration)
+		if !local.Before(start) && local.Before(end) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w MaintenanceWindow) String() string {
+	days := make([]string, 0, len(w.Days))
+	for _, day := range w.Days {
+		days = append(days, strings.ToLower(day.String()[:3]))
+	}
+	hours := int(w.Start.Hours())
+	minutes := int(w.Start.Minutes()) % 60
+	return fmt.Sprintf(
+		"%s %02d:%02d +%s (%s)",
+		strings.Join(days, ","), hours, minutes, w.Duration, w.Location,
+	)
+}
+
+func (w MaintenanceWindow) hasDay(weekday time.Weekday) bool {
+	for _, day := range w.Days {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,9 @@
 package common
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type ExtraMountModel struct {
 	Size       types.Int64  `tfsdk:"size"`
@@ -24,3 +27,24 @@ type ClusterProductImageDataSourceModel struct {
 
 	GeoDistribution types.Bool `tfsdk:"geo_distribution"`
 }
+
+type PlannedActionModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Disruptive  types.Bool   `tfsdk:"disruptive"`
+}
+
+func (m PlannedActionModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"disruptive":  types.BoolType,
+	}
+}
+
+type MaintenanceWindowModel struct {
+	Days     types.List   `tfsdk:"days"`
+	Start    types.String `tfsdk:"start"`
+	Duration types.String `tfsdk:"duration"`
+	Timezone types.String `tfsdk:"timezone"`
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProviderMaintenanceWindowSchema окно обслуживания по умолчанию, атрибуты совпадают с MaintenanceWindowSchema
+var ProviderMaintenanceWindowSchema = schema.SingleNestedAttribute{
+	Optional: true,
+	MarkdownDescription: "Окно обслуживания по умолчанию для ресурсов. Вне окна действия с перезагрузкой ВМ " +
+		"или перезапуском кластера запрещены.",
+	Attributes: map[string]schema.Attribute{
+		"days": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Required:            true,
+			MarkdownDescription: maintenanceWindowDaysDescription,
+			Validators:          maintenanceWindowDaysValidators,
+		},
+		"start": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: maintenanceWindowStartDescription,
+		},
+		"duration": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: maintenanceWindowDurationDescription,
+		},
+		"timezone": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: maintenanceWindowTimezoneDescription,
+		},
+	},
+}
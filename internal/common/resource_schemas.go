@@ -3,9 +3,14 @@ package common
 import (
 	"terraform-provider-vtb/internal/consts"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var CoreSchema = map[string]schema.Attribute{
@@ -107,3 +112,84 @@ var ClusterProductImageSchema = map[string]schema.Attribute{
 		MarkdownDescription: "Флаг для интеграции виртуальной машины в Active Directory",
 	},
 }
+
+var PlannedActionsSchema = schema.ListNestedAttribute{
+	Computed: true,
+	MarkdownDescription: "Упорядоченный список действий портала, которые будут выполнены при применении изменений. " +
+		"Действия с флагом disruptive приводят к перезагрузке ВМ или перезапуску кластера. " +
+		"После применения в state хранятся выполненные действия.",
+	PlanModifiers: []planmodifier.List{
+		listplanmodifier.UseStateForUnknown(),
+	},
+	NestedObject: schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Название действия на портале.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Описание изменения, которое выполняет действие.",
+			},
+			"disruptive": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Флаг означает, что действие приводит к перезагрузке или перезапуску.",
+			},
+		},
+	},
+}
+
+// описания и валидаторы окна обслуживания общие для схем ресурсов и провайдера
+const (
+	maintenanceWindowDaysDescription     = "Дни недели начала окна. Пример: [\"sat\", \"sun\"]"
+	maintenanceWindowStartDescription    = "Время начала окна в формате HH:MM. Пример: 22:00"
+	maintenanceWindowDurationDescription = "Продолжительность окна. Пример: 4h, 90m"
+	maintenanceWindowTimezoneDescription = "Часовой пояс окна (по умолчанию UTC). Пример: Europe/Moscow"
+)
+
+var maintenanceWindowDaysValidators = []validator.List{
+	listvalidator.SizeAtLeast(1),
+	listvalidator.ValueStringsAre(stringvalidator.OneOf(consts.MAINTENANCE_WINDOW_DAYS...)),
+}
+
+var MaintenanceWindowSchema = schema.SingleNestedAttribute{
+	Optional: true,
+	MarkdownDescription: "Окно обслуживания ресурса. Вне окна действия с перезагрузкой ВМ или перезапуском кластера " +
+		"запрещены. Если не задано, используется окно обслуживания из настроек провайдера.",
+	Attributes: map[string]schema.Attribute{
+		"days": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Required:            true,
+			MarkdownDescription: maintenanceWindowDaysDescription,
+			Validators:          maintenanceWindowDaysValidators,
+		},
+		"start": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: maintenanceWindowStartDescription,
+		},
+		"duration": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: maintenanceWindowDurationDescription,
+		},
+		"timezone": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: maintenanceWindowTimezoneDescription,
+		},
+	},
+}
+
+var MaintenanceOverrideSchema = schema.BoolAttribute{
+	Optional:            true,
+	MarkdownDescription: "Разрешить действия с перезагрузкой или перезапуском вне окна обслуживания.",
+}
+
+var PowerStateSchema = schema.StringAttribute{
+	Optional: true,
+	Computed: true,
+	Default:  stringdefault.StaticString("on"),
+	MarkdownDescription: "Состояние заказа (`on`/`off`). При значении `off` продукт останавливается, " +
+		"значение считывается из состояния элемента заказа на портале.",
+	Validators: []validator.String{
+		stringvalidator.OneOf("on", "off"),
+	},
+}
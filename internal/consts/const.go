@@ -30,3 +30,5 @@ var PLATFORMS = []string{
 	"Nutanix",
 	"ceph",
 }
+
+var MAINTENANCE_WINDOW_DAYS = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
@@ -0,0 +1,134 @@
+package orphans
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/pkg/client/orders"
+	"terraform-provider-vtb/pkg/client/productcatalog"
+)
+
+// UnmanagedOrder заказ проекта, которого нет в состоянии terraform
+type UnmanagedOrder struct {
+	ID               string     `json:"id"`
+	Label            string     `json:"label"`
+	Product          string     `json:"product"`
+	Status           string     `json:"status"`
+	CreatedAt        string     `json:"created_at"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	FinancialProject string     `json:"financial_project"`
+}
+
+// ManagedOrderIDsFromState достает order_id всех ресурсов провайдера из terraform.tfstate
+func ManagedOrderIDsFromState(statePath string) ([]string, error) {
+
+	content, err := os.ReadFile(statePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var state struct {
+		Version   int `json:"version"`
+		Resources []struct {
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Provider  string `json:"provider"`
+			Instances []struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	err = json.Unmarshal(content, &state)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse state file '%s': %w", statePath, err)
+	}
+	if state.Version != 4 {
+		return nil, fmt.Errorf("unsupported state file version %d, expected 4", state.Version)
+	}
+
+	var ids []string
+	for _, r := range state.Resources {
+		if r.Mode != "managed" || !strings.HasPrefix(r.Type, "vtb_") {
+			continue
+		}
+		for _, instance := range r.Instances {
+			// order_id, vtb_artemis_order_id, rabbitmq_order_id и т.п.
+			for name, value := range instance.Attributes {
+				id, ok := value.(string)
+				if ok && id != "" && (name == "order_id" || strings.HasSuffix(name, "_order_id")) {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	return ids, nil
+}
+
+// FindUnmanagedOrders возвращает заказы проекта, которые не входят в managedIDs
+func FindUnmanagedOrders(cloudClient *client.CloudClient, managedIDs []string) ([]UnmanagedOrder, error) {
+
+	managed := make(map[string]bool, len(managedIDs))
+	for _, id := range managedIDs {
+		managed[id] = true
+	}
+
+	projectOrders, err := orders.ListOrders(cloudClient.Creds, cloudClient.ProjectName)
+	if err != nil {
+		return nil, fmt.Errorf("can't get orders of project '%s': %w", cloudClient.ProjectName, err)
+	}
+
+	products := map[string]string{}
+	var result []UnmanagedOrder
+	for _, order := range projectOrders {
+		if managed[order.ID] {
+			continue
+		}
+
+		product, ok := products[order.ProductID]
+		if !ok {
+			product = productTitle(cloudClient, order.ProductID)
+			products[order.ProductID] = product
+		}
+
+		result = append(result, UnmanagedOrder{
+			ID:               order.ID,
+			Label:            order.Label,
+			Product:          product,
+			Status:           order.Status,
+			CreatedAt:        order.CreatedAt,
+			ExpiresAt:        expiresAt(order),
+			FinancialProject: order.FinancialSource.Name,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt < result[j].CreatedAt
+	})
+	return result, nil
+}
+
+// productTitle название продукта из каталога, при ошибке возвращается product_id
+func productTitle(cloudClient *client.CloudClient, productID string) string {
+	data, err := productcatalog.GetProductImageData(cloudClient.Creds, productID, cloudClient.Environment)
+	if err != nil || data.Title == "" {
+		return productID
+	}
+	return data.Title
+}
+
+func expiresAt(order orders.Order) *time.Time {
+	if order.Lifetime == 0 {
+		return nil
+	}
+	created, err := time.Parse(time.RFC3339, order.CreatedAt)
+	if err != nil {
+		return nil
+	}
+	expires := created.Add(time.Duration(order.Lifetime) * time.Second)
+	return &expires
+}
@@ -0,0 +1,186 @@
+package orphans
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/fake"
+	"terraform-provider-vtb/pkg/client/orders"
+)
+
+const computeProductID = "fake-compute-product-id"
+
+func writeState(t *testing.T, content string) string {
+	t.Helper()
+	statePath := filepath.Join(t.TempDir(), "terraform.tfstate")
+	if err := os.WriteFile(statePath, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return statePath
+}
+
+func TestManagedOrderIDsFromState(t *testing.T) {
+	cases := []struct {
+		name    string
+		state   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "order ids of provider resources",
+			state: `{"version": 4, "resources": [
+				{"mode": "managed", "type": "vtb_compute_instance", "instances": [
+					{"attributes": {"order_id": "vm-1", "item_id": "item-1"}},
+					{"attributes": {"order_id": "vm-2"}}
+				]},
+				{"mode": "managed", "type": "vtb_artemis_tuz", "instances": [
+					{"attributes": {"vtb_artemis_order_id": "artemis-1", "name": "tuz"}}
+				]},
+				{"mode": "managed", "type": "vtb_kafka_topic", "instances": [
+					{"attributes": {"kafka_order_id": "kafka-1", "order_id": ""}}
+				]}
+			]}`,
+			want: []string{"artemis-1", "kafka-1", "vm-1", "vm-2"},
+		},
+		{
+			name: "data sources and other providers are skipped",
+			state: `{"version": 4, "resources": [
+				{"mode": "data", "type": "vtb_compute_instance", "instances": [{"attributes": {"order_id": "data-1"}}]},
+				{"mode": "managed", "type": "null_resource", "instances": [{"attributes": {"order_id": "null-1"}}]},
+				{"mode": "managed", "type": "vtb_compute_instance", "instances": [{"attributes": {"order_id": 1}}]}
+			]}`,
+		},
+		{
+			name:    "unsupported version",
+			state:   `{"version": 3, "resources": []}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			state:   `{"version": 4,`,
+			wantErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ids, err := ManagedOrderIDsFromState(writeState(t, c.state))
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Expected error, got ids %v", ids)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			sort.Strings(ids)
+			if len(ids) != len(c.want) || (len(ids) > 0 && !reflect.DeepEqual(ids, c.want)) {
+				t.Fatalf("Expected ids %v, got %v", c.want, ids)
+			}
+		})
+	}
+
+	if _, err := ManagedOrderIDsFromState(filepath.Join(t.TempDir(), "missing.tfstate")); err == nil {
+		t.Fatal("Expected error for missing state file")
+	}
+}
+
+func TestFindUnmanagedOrders(t *testing.T) {
+	s := fake.Start(t)
+	s.AddProduct(computeProductID, fake.ComputeProduct("astra"))
+	s.HandleFunc(
+		http.MethodGet,
+		"/product-catalog/api/v2/products/"+computeProductID+"/optimized",
+		func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(map[string]string{"title": "Astra Linux"})
+		},
+	)
+
+	creds, err := auth.NewCredentials(fake.ClientID, fake.ClientSecret)
+	if err != nil {
+		t.Fatalf("Can't get access token from fake portal: %v", err)
+	}
+
+	created := map[string]*orders.Compute{}
+	for _, payload := range []orders.CreateOrderPayload{
+		{Label: "managed", FinProjectID: "fake-fin-project-id"},
+		{Label: "unmanaged", FinProjectID: "fake-fin-project-id"},
+		{Label: "temporary", FinProjectID: "fake-fin-project-id", Lifetime: 2},
+		{Label: "deleted", FinProjectID: "fake-fin-project-id"},
+	} {
+		compute := orders.NewCompute(creds, fake.ProjectName, computeProductID, orders.ComputeAttrs{
+			BasicAttrs: orders.BasicAttrs{
+				Domain: "corp.fake.ru",
+				Flavor: entities.Flavor{Cores: 2, Memory: 4, Name: "c2m4", UUID: "flavor-c2m4"},
+			},
+		})
+		if err := compute.Create(payload); err != nil {
+			t.Fatalf("Create order '%s': %v", payload.Label, err)
+		}
+		created[payload.Label] = compute
+	}
+	if err := created["deleted"].Delete(true); err != nil {
+		t.Fatalf("Delete order: %v", err)
+	}
+	if status, err := created["deleted"].GetOrderStatus(); err != nil || status != "deprovisioned" {
+		t.Fatalf("Expected deleted order to be deprovisioned, got %s (%v)", status, err)
+	}
+
+	project := s.Project
+	unmanaged, err := FindUnmanagedOrders(
+		client.NewCloudClient(creds, &project),
+		[]string{created["managed"].ID, "order-of-other-project"},
+	)
+	if err != nil {
+		t.Fatalf("Find unmanaged orders: %v", err)
+	}
+
+	var labels []string
+	for _, order := range unmanaged {
+		labels = append(labels, order.Label)
+		if order.ID != created[order.Label].ID {
+			t.Fatalf("Unexpected id of order '%s': %s", order.Label, order.ID)
+		}
+		if order.Product != "Astra Linux" || order.FinancialProject != "fake-fin-project" {
+			t.Fatalf("Unexpected product or financial project: %+v", order)
+		}
+	}
+	if expected := []string{"unmanaged", "temporary"}; !reflect.DeepEqual(labels, expected) {
+		t.Fatalf("Expected unmanaged orders %v, got %v", expected, labels)
+	}
+
+	if unmanaged[0].ExpiresAt != nil {
+		t.Fatalf("Order without lifetime mustn't expire, got %v", unmanaged[0].ExpiresAt)
+	}
+	createdAt, err := time.Parse(time.RFC3339, unmanaged[1].CreatedAt)
+	if err != nil {
+		t.Fatalf("Parse created_at: %v", err)
+	}
+	if expires := unmanaged[1].ExpiresAt; expires == nil || !expires.Equal(createdAt.Add(48*time.Hour)) {
+		t.Fatalf("Expected order to expire in 2 days after %v, got %v", createdAt, expires)
+	}
+}
+
+func TestProductTitleFallback(t *testing.T) {
+	s := fake.Start(t)
+	creds, err := auth.NewCredentials(fake.ClientID, fake.ClientSecret)
+	if err != nil {
+		t.Fatalf("Can't get access token from fake portal: %v", err)
+	}
+
+	project := s.Project
+	title := productTitle(client.NewCloudClient(creds, &project), "unknown-product-id")
+	if title != "unknown-product-id" {
+		t.Fatalf("Expected product_id when catalog doesn't know product, got %s", title)
+	}
+}
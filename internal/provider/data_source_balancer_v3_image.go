@@ -1,89 +0,0 @@
-package provider
-
-import (
-	"context"
-	"fmt"
-	"strings"
-
-	"terraform-provider-vtb/pkg/client/references"
-
-	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-)
-
-var (
-	_ datasource.DataSource = BalancerV3ImageDataSource{}
-)
-
-type BalancerV3ImageDataSource struct {
-	provider *VTBCloudProvider
-}
-
-
-func NewBalancerV3ImageDataSource(p *VTBCloudProvider) BalancerV3ImageDataSource {
-	return BalancerV3ImageDataSource{
-		provider: p,
-	}
-}
-
-func (d BalancerV3ImageDataSource) Metadata(
-	ctx context.Context,
-	req datasource.MetadataRequest,
-	resp *datasource.MetadataResponse,
-) {
-	resp.TypeName = "vtb_balancer_v3_image_data"
-}
-
-func (t BalancerV3ImageDataSource) Schema(
-	ctx context.Context,
-	req datasource.SchemaRequest,
-	resp *datasource.SchemaResponse,
-) {
-	resp.Schema = schema.Schema{
-		Attributes:          dataImageWithGeoDistributionSchema,
-		Description:         "Данные образа из сервиса справочников необходимые для оформления заказа.",
-		MarkdownDescription: "Данные образа из сервиса справочников необходимые для оформления заказа.",
-	}
-}
-
-func (d BalancerV3ImageDataSource) Read(
-	ctx context.Context,
-	req datasource.ReadRequest,
-	resp *datasource.ReadResponse,
-) {
-	var data ClusterProductImageDataSourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	image, err := references.GetBalancerV3ImageData(
-		d.provider.Creds,
-		data.Distribution.ValueString(),
-		d.provider.Organization,
-		d.provider.Environment,
-		data.OsVersion.ValueString(),
-	)
-	if err != nil {
-		resp.Diagnostics.AddError("Get Image data from references", err.Error())
-		return
-	}
-
-	if !strings.Contains(image.Version, data.OsVersion.ValueString()) {
-		resp.Diagnostics.AddError(
-			"Validate OS Version",
-			fmt.Sprintf("Unknown OS version %s", data.OsVersion.ValueString()),
-		)
-		return
-	}
-
-	data.OsVersion = types.StringValue(image.Version)
-	data.OnSupport = types.BoolValue(image.OnSupport)
-	data.ProductID = types.StringValue(image.ProductID)
-	data.ADIntegration = types.BoolValue(image.ADIntegration)
-	data.GeoDistribution = types.BoolValue(image.GeoDistribution)
-
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-}
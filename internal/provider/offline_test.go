@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// Offline тесты запускают провайдер в процессе теста против fake портала,
+// поэтому им не нужны учетные данные и доступ к порталу:
+// TF_ACC=1 go test ./internal/provider -run TestAccOffline
+
+const offlineProviderConfig = `
+provider "vtb" {
+	project_name  = "` + fake.ProjectName + `"
+	client_id     = "` + fake.ClientID + `"
+	client_secret = "` + fake.ClientSecret + `"
+}
+`
+
+var offlineProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"vtb": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// offlineLifecycles ресурсы, для которых есть offline тест жизненного цикла
+var offlineLifecycles = map[string]bool{
+	"vtb_compute_instance": true,
+	"vtb_kafka_acl":        true,
+	"vtb_kafka_instance":   true,
+	"vtb_kafka_quota":      true,
+	"vtb_kafka_topic":      true,
+	"vtb_k8s_cluster":      true,
+	"vtb_service_account":  true,
+}
+
+// offlineLifecyclesPending ресурсы, offline тест которых еще не написан.
+// Новый ресурс должен попасть в offlineLifecycles или явно в этот список
+var offlineLifecyclesPending = map[string]bool{
+	"vtb_access_group_instance":        true,
+	"vtb_agent_orchestration_instance": true,
+	"vtb_airflow_cluster":              true,
+	"vtb_airflow_standalone":           true,
+	"vtb_artemis_address_policy":       true,
+	"vtb_artemis_cluster":              true,
+	"vtb_artemis_roles":                true,
+	"vtb_artemis_tuz":                  true,
+	"vtb_balancer_v3_cluster":          true,
+	"vtb_clickhouse_cluster":           true,
+	"vtb_clickhouse_instance":          true,
+	"vtb_elasticsearch_cluster":        true,
+	"vtb_etcd_instance":                true,
+	"vtb_grafana_instance":             true,
+	"vtb_gslb_v1_cluster":              true,
+	"vtb_iam_role":                     true,
+	"vtb_k8s_space_project":            true,
+	"vtb_k8scontainer_space":           true,
+	"vtb_k8sproject_instance":          true,
+	"vtb_ktaas_instance":               true,
+	"vtb_nginx_instance":               true,
+	"vtb_open_messaging_instance":      true,
+	"vtb_postgresql_instance":          true,
+	"vtb_rabbitmq_cluster":             true,
+	"vtb_rabbitmq_user":                true,
+	"vtb_rabbitmq_vhosts":              true,
+	"vtb_redis_instance":               true,
+	"vtb_redis_sentinel_instance":      true,
+	"vtb_rqaas_instance":               true,
+	"vtb_s3_ceph_instance":             true,
+	"vtb_scylla_db_cluster_instance":   true,
+	"vtb_sync_xpert_cluster":           true,
+	"vtb_sync_xpert_connector":         true,
+	"vtb_tarantool_cluster":            true,
+	"vtb_wildfly_instance":             true,
+}
+
+// startOfflinePortal fake портал со справочниками, общими для всех продуктов
+func startOfflinePortal(t *testing.T) *fake.Server {
+	s := fake.Start(t)
+
+	for _, role := range []string{"superuser", "user"} {
+		s.AddReference("ldap_linux_acl", role, []string{"vm:linux:DEV"}, map[string]interface{}{
+			"group_name":  role,
+			"permissions": []string{"vm:linux:" + role},
+		})
+	}
+	return s
+}
+
+// testAccCheckActions проверяет полную последовательность действий, полученных порталом
+func testAccCheckActions(s *fake.Server, expected ...string) tfresource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var actual []string
+		for _, call := range s.ActionCalls() {
+			actual = append(actual, call.Name)
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("expected portal actions %v, got %v", expected, actual)
+		}
+		return nil
+	}
+}
+
+// testAccCheckAction проверяет payload последнего вызова действия
+func testAccCheckAction(
+	s *fake.Server,
+	name string,
+	check func(call fake.ActionCall) error,
+) tfresource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		calls := s.ActionCalls()
+		for i := len(calls) - 1; i >= 0; i-- {
+			if calls[i].Name == name {
+				return check(calls[i])
+			}
+		}
+		return fmt.Errorf("portal didn't receive action '%s'", name)
+	}
+}
+
+// testAccCheckOrdersDeleted проверяет, что после destroy все заказы удалены
+func testAccCheckOrdersDeleted(s *fake.Server) tfresource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		for _, order := range s.Orders() {
+			if order.Status != "deprovisioned" {
+				return fmt.Errorf("order '%s' wasn't deleted, status '%s'", order.ID, order.Status)
+			}
+		}
+		return nil
+	}
+}
+
+// TestAccOfflineCoverage проверяет, что каждый ресурс провайдера либо покрыт
+// offline тестом жизненного цикла, либо явно отложен в offlineLifecyclesPending
+func TestAccOfflineCoverage(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")()
+
+	registered := map[string]bool{}
+	for _, newResource := range p.Resources(ctx) {
+		metadata := &resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "vtb"}, metadata)
+		registered[metadata.TypeName] = true
+
+		t.Run(metadata.TypeName, func(t *testing.T) {
+			covered, pending := offlineLifecycles[metadata.TypeName], offlineLifecyclesPending[metadata.TypeName]
+			switch {
+			case covered && pending:
+				t.Errorf("resource has offline lifecycle test, remove it from offlineLifecyclesPending")
+			case !covered && !pending:
+				t.Errorf("resource has no offline lifecycle test, add it or list the resource in offlineLifecyclesPending")
+			}
+		})
+	}
+
+	for _, listed := range []map[string]bool{offlineLifecycles, offlineLifecyclesPending} {
+		for name := range listed {
+			if !registered[name] {
+				t.Errorf("resource '%s' isn't registered in provider", name)
+			}
+		}
+	}
+}
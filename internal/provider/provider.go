@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
 	"terraform-provider-vtb/internal/services/access"
 	agentorchestration "terraform-provider-vtb/internal/services/agent_orchestration"
 	"terraform-provider-vtb/internal/services/airflow"
@@ -20,9 +21,11 @@ import (
 	"terraform-provider-vtb/internal/services/core"
 	"terraform-provider-vtb/internal/services/elasticsearch"
 	"terraform-provider-vtb/internal/services/etcd"
+	financialproject "terraform-provider-vtb/internal/services/financial_project"
 	"terraform-provider-vtb/internal/services/flavor"
 	"terraform-provider-vtb/internal/services/grafana"
 	gslbv1 "terraform-provider-vtb/internal/services/gslb_v1"
+	"terraform-provider-vtb/internal/services/iam"
 	k8scluster "terraform-provider-vtb/internal/services/k8s_cluster"
 	k8scontainerproject "terraform-provider-vtb/internal/services/k8s_container_project"
 	k8scontainerspace "terraform-provider-vtb/internal/services/k8s_container_space"
@@ -43,7 +46,9 @@ import (
 	"terraform-provider-vtb/internal/services/tarantool"
 	vtbartemis "terraform-provider-vtb/internal/services/vtb-artemis"
 	"terraform-provider-vtb/internal/services/wildfly"
+	"terraform-provider-vtb/internal/utils"
 	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/requests"
 	"terraform-provider-vtb/pkg/client/sources"
 )
 
@@ -74,6 +79,9 @@ type VTBProviderModel struct {
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
 	ProjectName  types.String `tfsdk:"project_name"`
+
+	MaintenanceWindow  *common.MaintenanceWindowModel `tfsdk:"maintenance_window"`
+	ForceOutsideWindow types.Bool                     `tfsdk:"force_outside_window"`
 }
 
 func (p *VTBCloudProvider) Schema(
@@ -98,6 +106,11 @@ func (p *VTBCloudProvider) Schema(
 				MarkdownDescription: "Name of project where will placed orders",
 				Required:            true,
 			},
+			"maintenance_window": common.ProviderMaintenanceWindowSchema,
+			"force_outside_window": schema.BoolAttribute{
+				MarkdownDescription: "Allow disruptive actions outside maintenance window",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -113,6 +126,11 @@ func (p *VTBCloudProvider) Configure(
 		return
 	}
 
+	if err := requests.EnvURLError(); err != nil {
+		resp.Diagnostics.AddError("Invalid PORTAL_API_URL or PORTAL_AUTH_URL", err.Error())
+		return
+	}
+
 	creds, err := auth.NewCredentials(
 		config.ClientId.ValueString(),
 		config.ClientSecret.ValueString(),
@@ -131,9 +149,17 @@ func (p *VTBCloudProvider) Configure(
 		return
 	}
 
+	maintenanceWindow, diags := utils.NewMaintenanceWindow(ctx, config.MaintenanceWindow)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	p.configured = true
 
 	client := client.NewCloudClient(creds, project)
+	client.MaintenanceWindow = maintenanceWindow
+	client.ForceOutsideWindow = config.ForceOutsideWindow.ValueBool()
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -158,7 +184,11 @@ func (p *VTBCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		// USPA Products
 		func() resource.Resource { return access.NewAccessGroupResource() },
 		func() resource.Resource { return astra.NewComputeResource() },
+		// vtb_compute_snapshot не регистрируется, пока имена действий снимков не сверены с графом ВМ
 		func() resource.Resource { return kafka.NewKafkaResource() },
+		func() resource.Resource { return kafka.NewKafkaTopicResource() },
+		func() resource.Resource { return kafka.NewKafkaACLResource() },
+		func() resource.Resource { return kafka.NewKafkaQuotaResource() },
 		func() resource.Resource { return wildfly.NewWildflyResource() },
 		func() resource.Resource { return nginx.NewNginxResource() },
 		func() resource.Resource { return openmessaging.NewOpenMessagingResource() },
@@ -193,6 +223,10 @@ func (p *VTBCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		func() resource.Resource { return k8scluster.NewK8sClusterResource() },
 		func() resource.Resource { return k8scontainerspace.NewK8sContainerSpaceResource() },
 		func() resource.Resource { return k8scontainerproject.NewK8sSpaceProjectResource() },
+
+		// IAM
+		func() resource.Resource { return iam.NewIAMRoleResource() },
+		func() resource.Resource { return iam.NewServiceAccountResource() },
 	}
 }
 
@@ -201,8 +235,17 @@ func (p *VTBCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		func() datasource.DataSource { return clusterlayout.NewClusterProductLayoutDataSource() },
 		func() datasource.DataSource { return flavor.NewFlavorDataSource() },
 		func() datasource.DataSource { return access.NewUserDataSource() },
+		func() datasource.DataSource { return access.NewUsersDataSource() },
+		func() datasource.DataSource { return access.NewAccessGroupsDataSource() },
+		func() datasource.DataSource { return access.NewAccessGroupPurposesDataSource() },
 		func() datasource.DataSource { return core.NewCoreDataSource() },
+		func() datasource.DataSource { return core.NewNetSegmentsDataSource() },
+		func() datasource.DataSource { return core.NewDomainsDataSource() },
+		func() datasource.DataSource { return core.NewAvailabilityZonesDataSource() },
+		func() datasource.DataSource { return core.NewDataCentersDataSource() },
+		func() datasource.DataSource { return core.NewPlatformsDataSource() },
 		func() datasource.DataSource { return astra.NewComputeImageDataSource() },
+		func() datasource.DataSource { return astra.NewComputeSnapshotsDataSource() },
 		func() datasource.DataSource { return postgresql.NewPostgresqlImageDataSource() },
 		func() datasource.DataSource { return wildfly.NewWildflyImageDataSource() },
 		func() datasource.DataSource { return nginx.NewNginxImageDataSource() },
@@ -211,6 +254,7 @@ func (p *VTBCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		func() datasource.DataSource { return clickhouse.NewClickhouseClusterImageDataSource() },
 		func() datasource.DataSource { return openmessaging.NewOpenMessagingDataSource() },
 		func() datasource.DataSource { return kafka.NewKafkaImageDataSource() },
+		func() datasource.DataSource { return kafka.NewKafkaCatalogDataSource() },
 		func() datasource.DataSource { return rabbitmq.NewRabbitMQImageDataSource() },
 		func() datasource.DataSource { return vtbartemis.NewArtemisImageDataSource() },
 		func() datasource.DataSource { return syncxpert.NewDebeziumImageDataSource() },
@@ -228,5 +272,10 @@ func (p *VTBCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		func() datasource.DataSource { return scylladb.NewScyllaDbClusterImageDataSource() },
 		func() datasource.DataSource { return s3ceph.NewS3CephImageDataSource() },
 		func() datasource.DataSource { return gslbv1.NewGSLBV1ImageDataSource() },
+		func() datasource.DataSource { return iam.NewIAMRoleDataSource() },
+		func() datasource.DataSource { return iam.NewIAMServiceRolesDataSource() },
+		func() datasource.DataSource { return iam.NewServiceAccountsDataSource() },
+		func() datasource.DataSource { return financialproject.NewFinancialProjectDataSource() },
+		func() datasource.DataSource { return financialproject.NewFinancialProjectsDataSource() },
 	}
 }
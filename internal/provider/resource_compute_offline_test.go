@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const offlineComputeProductID = "fake-compute-product-id"
+
+func testAccOfflineComputeConfig(flavor string, cores, memory, mountSize int, access string) string {
+	return fmt.Sprintf(`
+resource "vtb_compute_instance" "test" {
+	label             = "TerraformComputeOffline"
+	financial_project = "fake-fin-project"
+	core = {
+		platform    = "OpenStack"
+		domain      = "corp.dev.vtb"
+		net_segment = "dev-srv-app"
+		zone        = "msk-north"
+	}
+	flavor = {
+		name   = "%[1]s"
+		uuid   = "flavor-%[1]s"
+		cores  = %[2]d
+		memory = %[3]d
+	}
+	image = {
+		distribution   = "astra"
+		os_version     = "1.7"
+		on_support     = false
+		product_id     = "%[4]s"
+		ad_integration = true
+	}
+	extra_mounts = {
+		"/app" = {
+			size = %[5]d
+		}
+	}
+	access = %[6]s
+}
+`, flavor, cores, memory, offlineComputeProductID, mountSize, access)
+}
+
+// testAccOfflineComputePowerConfig конфиг последнего шага основного теста с управлением питанием
+func testAccOfflineComputePowerConfig(powerState, rebootTrigger string) string {
+	config := testAccOfflineComputeConfig(
+		"c4m8", 4, 8, 20, `{ "superuser" = ["cloud-group", "ops-group"] }`,
+	)
+	return strings.Replace(config, "\taccess = ", fmt.Sprintf(
+		"\tpower_state    = %q\n\treboot_trigger = %q\n\taccess = ", powerState, rebootTrigger,
+	), 1)
+}
+
+func TestAccOfflineComputeResource(t *testing.T) {
+	s := startOfflinePortal(t)
+	s.AddProduct(offlineComputeProductID, fake.ComputeProduct("astra"))
+
+	resourceName := "vtb_compute_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckOrdersDeleted(s),
+			testAccCheckActions(s,
+				"create", "expand_mount_point_new", "resize_vm", "vm_acls_add", "vm_acls_set_linux",
+				"vm_acls_remove", "reset_vm", "stop_vm_soft", "start_vm", "reset_vm", "delete_vm",
+			),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: offlineProviderConfig + testAccOfflineComputeConfig(
+					"c2m4", 2, 4, 10, `{ "superuser" = ["cloud-group"] }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "order_id"),
+					resource.TestCheckResourceAttr(resourceName, "hostname", "fake-vm-01"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_ip", "10.0.0.11"),
+					resource.TestCheckResourceAttr(resourceName, "extra_mounts./app.file_system", "xfs"),
+					testAccCheckActions(s, "create"),
+					testAccCheckAction(s, "create", func(call fake.ActionCall) error {
+						grants, _ := call.Attrs["ad_logon_grants"].([]interface{})
+						if len(grants) != 1 || call.Attrs["platform"] != "OpenStack" {
+							return fmt.Errorf("unexpected create attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineComputeConfig(
+					"c4m8", 4, 8, 20, `{ "superuser" = ["cloud-group", "ops-group"], "user" = ["dev-group"] }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "flavor.name", "c4m8"),
+					resource.TestCheckResourceAttr(resourceName, "extra_mounts./app.size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "access.user.#", "1"),
+					testAccCheckActions(s,
+						"create", "expand_mount_point_new", "resize_vm", "vm_acls_add", "vm_acls_set_linux",
+					),
+					testAccCheckAction(s, "expand_mount_point_new", func(call fake.ActionCall) error {
+						if call.Attrs["mount"] != "/app" || call.Attrs["size"] != float64(10) {
+							return fmt.Errorf("unexpected expand_mount_point_new attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+					testAccCheckAction(s, "resize_vm", func(call fake.ActionCall) error {
+						flavor, _ := call.Attrs["flavor"].(map[string]interface{})
+						if flavor["name"] != "c4m8" || flavor["cores"] != float64(4) {
+							return fmt.Errorf("unexpected resize_vm attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+					testAccCheckAction(s, "vm_acls_add", func(call fake.ActionCall) error {
+						role, _ := call.Attrs["role"].(map[string]interface{})
+						permissions, _ := role["permissions"].([]interface{})
+						if role["group_name"] != "user" || len(permissions) != 1 {
+							return fmt.Errorf("unexpected vm_acls_add attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+					testAccCheckAction(s, "vm_acls_set_linux", func(call fake.ActionCall) error {
+						members, _ := call.Attrs["members"].([]interface{})
+						if call.Attrs["role"] != "superuser" || len(members) != 2 {
+							return fmt.Errorf("unexpected vm_acls_set_linux attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"lifetime",
+					"maintenance_override",
+					"planned_actions",
+				},
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineComputeConfig(
+					"c4m8", 4, 8, 20, `{ "superuser" = ["cloud-group", "ops-group"] }`,
+				),
+				Check: testAccCheckActions(s,
+					"create", "expand_mount_point_new", "resize_vm", "vm_acls_add", "vm_acls_set_linux",
+					"vm_acls_remove",
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineComputePowerConfig("off", "patch-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "off"),
+					// перезагрузка выполняется до выключения
+					testAccCheckActions(s,
+						"create", "expand_mount_point_new", "resize_vm", "vm_acls_add", "vm_acls_set_linux",
+						"vm_acls_remove", "reset_vm", "stop_vm_soft",
+					),
+				),
+			},
+			{
+				Config:      offlineProviderConfig + testAccOfflineComputePowerConfig("off", "patch-2"),
+				ExpectError: regexp.MustCompile("Can't reboot virtual machine with power_state 'off'"),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineComputePowerConfig("on", "patch-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "on"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_trigger", "patch-2"),
+					testAccCheckActions(s,
+						"create", "expand_mount_point_new", "resize_vm", "vm_acls_add", "vm_acls_set_linux",
+						"vm_acls_remove", "reset_vm", "stop_vm_soft", "start_vm", "reset_vm",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const offlineK8sClusterProductID = "fake-k8s-cluster-product-id"
+
+func testAccOfflineK8sClusterConfig(regionSize int) string {
+	return fmt.Sprintf(`
+resource "vtb_k8s_cluster" "test" {
+	label              = "TerraformK8sClusterOffline"
+	financial_project  = "fake-fin-project"
+	domain             = "corp.dev.vtb"
+	platform           = "OpenStack"
+	data_center        = "5"
+	net_segment        = "dev-srv-app"
+	control_panel_size = "small"
+	balancer_dns_zone  = "dev.fake.zone"
+	gslb_only          = false
+
+	container_cpu_ratio    = 1
+	container_memory_ratio = 1
+
+	version = {
+		k8s_version     = "1.28"
+		product_version = "1.28.3"
+	}
+	cni_plugin = {
+		name = "calico"
+	}
+	components = {}
+	control_plane = [
+		{
+			role   = "master"
+			flavor = { name = "c4m8", uuid = "flavor-c4m8", cores = 4, memory = 8 }
+		},
+		{
+			role   = "infra"
+			size   = 2
+			flavor = { name = "c4m8", uuid = "flavor-c4m8", cores = 4, memory = 8 }
+		},
+		{
+			role   = "monitoring"
+			flavor = { name = "c4m8", uuid = "flavor-c4m8", cores = 4, memory = 8 }
+		},
+	]
+	regions = [
+		{
+			size    = %d
+			flavor  = { name = "c8m16", uuid = "flavor-c8m16", cores = 8, memory = 16 }
+			iscodes = ["fake-is"]
+		},
+	]
+	ingress = [
+		{
+			size     = 2
+			flavor   = { name = "c4m8", uuid = "flavor-c4m8", cores = 4, memory = 8 }
+			iscodes  = ["fake-is"]
+			features = { http2_protocol = true, proxy_protocol = false }
+		},
+	]
+}
+`, regionSize)
+}
+
+// offlineListHandler ответ order-service со списком, для эндпоинтов, которые fake портал не эмулирует
+func offlineListHandler(items ...map[string]interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"list": items,
+			"meta": map[string]interface{}{"total_count": len(items)},
+		})
+	}
+}
+
+// startOfflineK8sClusterPortal fake портал со справочниками kubernetes_v1 1.28,
+// доменами, сетевыми сегментами и дата-центрами для проверок ModifyPlan
+func startOfflineK8sClusterPortal(t *testing.T) *fake.Server {
+	s := startOfflinePortal(t)
+	s.AddProduct(offlineK8sClusterProductID, fake.K8sClusterProduct("28"))
+
+	s.AddReference("terraform", "k8s_cluster", []string{"k8s", "cluster", "fake-organization", "dev"}, map[string]interface{}{
+		"products": map[string]interface{}{
+			"kubernetes_v1_28_cluster": map[string]interface{}{
+				"name":             "kubernetes_v1_28_cluster",
+				"product_id":       offlineK8sClusterProductID,
+				"allowed_versions": []string{"1.28.3"},
+			},
+		},
+		"region_ingress_add_max":         3,
+		"region_add_nodes_max":           5,
+		"ingress_add_nodes_max":          5,
+		"default_region_size":            3,
+		"default_ingress_size":           2,
+		"default_container_cpu_ratio":    1,
+		"default_container_memory_ratio": 1,
+		"control_plane": map[string]interface{}{
+			"small": []map[string]interface{}{
+				{"role": "master", "flavor": "c4m8"},
+				{"role": "infra", "flavor": "c4m8", "size": 2},
+				{"role": "monitoring", "flavor": "c4m8"},
+			},
+		},
+	})
+	s.AddReference("gslb_servers", "dev.fake.zone", []string{"dev-srv-app", "available", "RELEASE"}, map[string]interface{}{
+		"name": "dev.fake.zone",
+	})
+
+	s.HandleFunc("GET", "/order-service/api/v1/domains", offlineListHandler(
+		map[string]interface{}{"code": "corp.dev.vtb", "label": "corp.dev.vtb"},
+	))
+	s.HandleFunc("GET", "/order-service/api/v1/net_segments", offlineListHandler(
+		map[string]interface{}{"code": "dev-srv-app", "label": "dev-srv-app"},
+	))
+	s.HandleFunc("GET", "/order-service/api/v1/data_centers", offlineListHandler(
+		map[string]interface{}{"code": "5", "site": "msk-north", "label": "DC5"},
+	))
+	return s
+}
+
+func TestAccOfflineK8sClusterResource(t *testing.T) {
+	s := startOfflineK8sClusterPortal(t)
+
+	resourceName := "vtb_k8s_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckOrdersDeleted(s),
+			testAccCheckActions(s,
+				"create",
+				"kubernetes_v1_28_cluster_region_add_nodes",
+				"kubernetes_v1_28_cluster_region_uncordon_nodes",
+				"kubernetes_v1_28_cluster_delete",
+			),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: offlineProviderConfig + testAccOfflineK8sClusterConfig(3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "order_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "fake-k8s-cluster"),
+					resource.TestCheckResourceAttr(resourceName, "regions.0.name", "fake-region-01"),
+					resource.TestCheckResourceAttr(resourceName, "regions.0.pod_cpu_max", "7"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.name", "fake-ingress-01"),
+					testAccCheckActions(s, "create"),
+					testAccCheckAction(s, "create", func(call fake.ActionCall) error {
+						worker, _ := call.Attrs["worker"].(map[string]interface{})
+						if call.Attrs["data_center"] != "5" || worker["size"] != float64(3) {
+							return fmt.Errorf("unexpected create attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineK8sClusterConfig(4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "regions.0.size", "4"),
+					testAccCheckActions(s,
+						"create",
+						"kubernetes_v1_28_cluster_region_add_nodes",
+						"kubernetes_v1_28_cluster_region_uncordon_nodes",
+					),
+					testAccCheckAction(s, "kubernetes_v1_28_cluster_region_add_nodes", func(call fake.ActionCall) error {
+						if call.Attrs["name"] != "fake-region-01" || call.Attrs["new_nodes_count"] != float64(1) ||
+							call.Attrs["availability_zone"] != "msk-north" {
+							return fmt.Errorf("unexpected region_add_nodes attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"lifetime",
+					"planned_actions",
+				},
+			},
+		},
+	})
+}
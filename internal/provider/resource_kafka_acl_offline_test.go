@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccOfflineKafkaACLConfig кластер с ACL клиента cluster-client и отдельным ресурсом ACL
+// клиента external-client
+func testAccOfflineKafkaACLConfig(layoutID, ownership, aclAttrs string) string {
+	cluster := testAccOfflineKafkaClusterConfig(layoutID, "c2m4", 2, 4, fmt.Sprintf(`
+	topics = {%s
+	}
+	acls_ownership = "%s"
+	acls = {
+		"cluster-client" = {
+			consumer_by_name = ["orders"]
+		}
+	}`, offlineKafkaOrdersTopic, ownership))
+
+	return cluster + fmt.Sprintf(`
+resource "vtb_kafka_acl" "test" {
+	kafka_order_id   = vtb_kafka_instance.test.order_id
+	client_cn        = "external-client"
+	consumer_by_name = ["orders"]%s
+}
+`, aclAttrs)
+}
+
+// testAccCheckKafkaACLsAction проверяет ACL в payload последнего вызова действия
+func testAccCheckKafkaACLsAction(s *fake.Server, name string, expected ...map[string]interface{}) resource.TestCheckFunc {
+	return testAccCheckAction(s, name, func(call fake.ActionCall) error {
+		acls := call.Attrs["acls"].([]interface{})
+		if len(acls) != len(expected) {
+			return fmt.Errorf("unexpected %s attrs: %v", name, call.Attrs)
+		}
+		for i, acl := range acls {
+			for key, value := range expected[i] {
+				if fmt.Sprint(acl.(map[string]interface{})[key]) != fmt.Sprint(value) {
+					return fmt.Errorf("unexpected %s attrs: %v", name, call.Attrs)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func TestAccOfflineKafkaACLResource(t *testing.T) {
+	s, layoutID := startOfflineKafkaPortal(t)
+
+	clusterName := "vtb_kafka_instance.test"
+	resourceName := "vtb_kafka_acl.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckOrdersDeleted(s),
+			testAccCheckKafkaACLsAction(s, "kafka_delete_acls",
+				map[string]interface{}{"client_cn": "external-client", "topic_type": "by_name"},
+				map[string]interface{}{"client_cn": "external-client", "topic_type": "by_mask"},
+			),
+		),
+		Steps: []resource.TestStep{
+			// в режиме shared кластер не видит ACL отдельного ресурса
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaACLConfig(layoutID, "shared", ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterName, "acls.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_by_name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "producer_by_mask.#", "0"),
+					testAccCheckActions(s, "create", "kafka_create_topics", "kafka_create_acls", "kafka_create_acls"),
+					testAccCheckKafkaACLsAction(s, "kafka_create_acls", map[string]interface{}{
+						"client_cn":   "external-client",
+						"client_role": "consumer",
+						"topic_names": []interface{}{"orders"},
+					}),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaACLConfig(layoutID, "shared", `
+	producer_by_mask = ["orders."]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(clusterName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "producer_by_mask.#", "1"),
+					testAccCheckKafkaACLsAction(s, "kafka_create_acls", map[string]interface{}{
+						"client_cn":   "external-client",
+						"client_role": "producer",
+						"topic_type":  "by_mask",
+						"topic_name":  "orders.",
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "client_cn",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attrs := state.RootModule().Resources[resourceName].Primary.Attributes
+					return attrs["kafka_order_id"] + "/" + attrs["client_cn"], nil
+				},
+			},
+			// в режиме exclusive кластер забирает ACL клиента себе и планирует их удаление
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaACLConfig(layoutID, "exclusive", `
+	producer_by_mask = ["orders."]`),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(clusterName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterName, "acls_ownership", "exclusive"),
+					testAccCheckActions(s,
+						"create", "kafka_create_topics", "kafka_create_acls", "kafka_create_acls", "kafka_create_acls",
+					),
+				),
+			},
+		},
+	})
+}
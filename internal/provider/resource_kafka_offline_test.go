@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const offlineKafkaProductID = "fake-kafka-product-id"
+
+const offlineKafkaOrdersTopic = `
+		"orders" = {
+			cleanup_policy = "delete"
+			partitions     = 3
+			retention_ms   = 86400000
+		}`
+
+const offlineKafkaEventsTopic = `
+		"events" = {
+			cleanup_policy  = "delete"
+			partitions      = 1
+			retention_bytes = 268435456
+		}`
+
+func testAccOfflineKafkaConfig(layoutID, flavor string, cores, memory int, topics string) string {
+	return testAccOfflineKafkaClusterConfig(layoutID, flavor, cores, memory, fmt.Sprintf(`
+	topics = {%s
+	}`, topics))
+}
+
+// testAccOfflineKafkaClusterConfig кластер kafka с дополнительными атрибутами attrs (топики, ownership)
+func testAccOfflineKafkaClusterConfig(layoutID, flavor string, cores, memory int, attrs string) string {
+	return fmt.Sprintf(`
+resource "vtb_kafka_instance" "test" {
+	label             = "TerraformKafkaOffline"
+	financial_project = "fake-fin-project"
+	layout_id         = "%[1]s"
+	cluster_name      = "kafka-offline"
+	kafka_version     = "2.13-3.6.2"
+	core = {
+		platform    = "OpenStack"
+		domain      = "corp.dev.vtb"
+		net_segment = "dev-srv-app"
+		zone        = "msk-north"
+	}
+	flavor = {
+		name   = "%[2]s"
+		uuid   = "flavor-%[2]s"
+		cores  = %[3]d
+		memory = %[4]d
+	}
+	image = {
+		distribution          = "astra"
+		os_version            = "1.7"
+		default_kafka_version = "2.13-3.6.2"
+		geo_distribution      = false
+		on_support            = false
+		product_id            = "%[5]s"
+		ad_integration        = true
+	}
+	extra_mounts = {
+		"/app" = {
+			size = 50
+		}
+	}
+	access = {
+		"kafka_admin" = ["cloud-group"]
+	}%[6]s
+}
+`, layoutID, flavor, cores, memory, offlineKafkaProductID, attrs)
+}
+
+// startOfflineKafkaPortal fake портал со справочниками kafka, возвращает id layout one_dc:kafka:zookeeper
+func startOfflineKafkaPortal(t *testing.T) (*fake.Server, string) {
+	s := startOfflinePortal(t)
+	s.AddProduct(offlineKafkaProductID, fake.KafkaProduct("astra"))
+
+	s.AddReference("ldap_linux_acl", "kafka_admin", []string{"cluster:kafka:DEV"}, map[string]interface{}{
+		"group_name": "kafka_admin",
+	})
+	s.AddReference("terraform", "kafka", []string{"kafka", "astra", "fake-organization", "dev"}, map[string]interface{}{
+		"product_id":            offlineKafkaProductID,
+		"default_kafka_version": "2.13-3.6.2",
+	})
+	s.AddReference("images", "astra-1.7", []string{"general"}, map[string]interface{}{
+		"os": map[string]interface{}{"distribution": "astra", "version": "1.7"},
+	})
+	layoutID := s.AddReference(
+		"geo_distribution", "one_dc:kafka:zookeeper",
+		[]string{"kafka", "fake-organization", "dev-srv-app"}, map[string]interface{}{},
+	)
+	return s, layoutID
+}
+
+func TestAccOfflineKafkaResource(t *testing.T) {
+	s, layoutID := startOfflineKafkaPortal(t)
+
+	resourceName := "vtb_kafka_instance.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckOrdersDeleted(s),
+			testAccCheckActions(s,
+				"create", "kafka_create_topics", "kafka_create_topics", "resize_kafka_cluster_vms",
+				"kafka_delete_topics", "delete_two_layer",
+			),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaConfig(
+					layoutID, "c2m4", 2, 4, offlineKafkaOrdersTopic,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "order_id"),
+					resource.TestCheckResourceAttr(resourceName, "connection_url", "fake-vm-02.corp.dev.vtb:9092"),
+					resource.TestCheckResourceAttr(resourceName, "topics.orders.segment_size_mb", "1024"),
+					testAccCheckActions(s, "create", "kafka_create_topics"),
+					testAccCheckAction(s, "create", func(call fake.ActionCall) error {
+						flavor, _ := call.Attrs["one_node_flavor"].(map[string]interface{})
+						if call.Attrs["layout"] != layoutID || flavor["name"] != "c2m4" {
+							return fmt.Errorf("unexpected create attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+					testAccCheckAction(s, "kafka_create_topics", func(call fake.ActionCall) error {
+						topics, _ := call.Attrs["topics"].([]interface{})
+						topic, _ := topics[0].(map[string]interface{})
+						if len(topics) != 1 || topic["topic_name"] != "orders" || topic["_cleanup^limit_by"] != "time" {
+							return fmt.Errorf("unexpected kafka_create_topics attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaConfig(
+					layoutID, "c4m8", 4, 8, offlineKafkaOrdersTopic+offlineKafkaEventsTopic,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "flavor.name", "c4m8"),
+					resource.TestCheckResourceAttr(resourceName, "topics.%", "2"),
+					testAccCheckActions(s,
+						"create", "kafka_create_topics", "kafka_create_topics", "resize_kafka_cluster_vms",
+					),
+					testAccCheckAction(s, "kafka_create_topics", func(call fake.ActionCall) error {
+						topics, _ := call.Attrs["topics"].([]interface{})
+						topic, _ := topics[0].(map[string]interface{})
+						if len(topics) != 1 || topic["topic_name"] != "events" || topic["_cleanup^limit_by"] != "size" {
+							return fmt.Errorf("unexpected kafka_create_topics attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"lifetime",
+					"maintenance_override",
+					"planned_actions",
+				},
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaConfig(
+					layoutID, "c4m8", 4, 8, offlineKafkaEventsTopic,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "topics.%", "1"),
+					testAccCheckAction(s, "kafka_delete_topics", func(call fake.ActionCall) error {
+						topics, _ := call.Attrs["topics"].([]interface{})
+						if len(topics) != 1 || topics[0] != "orders" {
+							return fmt.Errorf("unexpected kafka_delete_topics attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
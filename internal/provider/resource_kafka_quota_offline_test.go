@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccOfflineKafkaQuotaConfig кластер с квотой по умолчанию и отдельным ресурсом квоты клиента external-client
+func testAccOfflineKafkaQuotaConfig(layoutID, ownership string, producerByteRate int) string {
+	cluster := testAccOfflineKafkaClusterConfig(layoutID, "c2m4", 2, 4, fmt.Sprintf(`
+	topics = {%s
+	}
+	quotas_ownership = "%s"
+	quotas = [
+		{
+			type               = "default"
+			producer_byte_rate = 131072
+		}
+	]`, offlineKafkaOrdersTopic, ownership))
+
+	return cluster + fmt.Sprintf(`
+resource "vtb_kafka_quota" "test" {
+	kafka_order_id     = vtb_kafka_instance.test.order_id
+	type               = "personal"
+	client_cn          = "external-client"
+	producer_byte_rate = %d
+}
+`, producerByteRate)
+}
+
+// testAccCheckKafkaQuotasAction проверяет единственную квоту в payload последнего вызова действия
+func testAccCheckKafkaQuotasAction(s *fake.Server, name string, producerByteRate int) resource.TestCheckFunc {
+	return testAccCheckAction(s, name, func(call fake.ActionCall) error {
+		quotas := call.Attrs["quotas"].([]interface{})
+		quota := quotas[0].(map[string]interface{})
+		clientCNs := quota["client_cns"].([]interface{})
+		if len(quotas) != 1 || len(clientCNs) != 1 || clientCNs[0] != "external-client" ||
+			(producerByteRate != 0 && quota["producer_byte_rate"] != float64(producerByteRate)) {
+			return fmt.Errorf("unexpected %s attrs: %v", name, call.Attrs)
+		}
+		return nil
+	})
+}
+
+func TestAccOfflineKafkaQuotaResource(t *testing.T) {
+	s, layoutID := startOfflineKafkaPortal(t)
+
+	clusterName := "vtb_kafka_instance.test"
+	resourceName := "vtb_kafka_quota.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckOrdersDeleted(s),
+			testAccCheckKafkaQuotasAction(s, "kafka_delete_quotas", 0),
+		),
+		Steps: []resource.TestStep{
+			// в режиме shared кластер не видит квоту отдельного ресурса
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaQuotaConfig(layoutID, "shared", 262144),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterName, "quotas.#", "1"),
+					resource.TestCheckResourceAttr(clusterName, "quotas.0.type", "default"),
+					resource.TestCheckResourceAttr(resourceName, "producer_byte_rate", "262144"),
+					testAccCheckActions(s, "create", "kafka_create_quotas", "kafka_create_topics", "kafka_create_quotas"),
+					testAccCheckKafkaQuotasAction(s, "kafka_create_quotas", 262144),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaQuotaConfig(layoutID, "shared", 524288),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(clusterName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "producer_byte_rate", "524288"),
+					testAccCheckKafkaQuotasAction(s, "kafka_create_quotas", 524288),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "client_cn",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attrs := state.RootModule().Resources[resourceName].Primary.Attributes
+					return attrs["kafka_order_id"] + "/" + attrs["client_cn"], nil
+				},
+			},
+			// в режиме exclusive кластер забирает квоту себе и планирует ее удаление
+			{
+				Config:             offlineProviderConfig + testAccOfflineKafkaQuotaConfig(layoutID, "exclusive", 524288),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(clusterName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterName, "quotas_ownership", "exclusive"),
+					testAccCheckActions(s,
+						"create", "kafka_create_quotas", "kafka_create_topics", "kafka_create_quotas",
+						"kafka_create_quotas",
+					),
+				),
+			},
+		},
+	})
+}
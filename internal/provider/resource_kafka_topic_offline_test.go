@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccOfflineKafkaTopicConfig кластер с топиком orders и отдельным ресурсом топика external
+func testAccOfflineKafkaTopicConfig(layoutID, ownership string, partitions int) string {
+	cluster := testAccOfflineKafkaClusterConfig(layoutID, "c2m4", 2, 4, fmt.Sprintf(`
+	topics_ownership = "%s"
+	topics = {%s
+	}`, ownership, offlineKafkaOrdersTopic))
+
+	return cluster + fmt.Sprintf(`
+resource "vtb_kafka_topic" "test" {
+	kafka_order_id = vtb_kafka_instance.test.order_id
+	name           = "external"
+	cleanup_policy = "delete"
+	partitions     = %d
+	retention_ms   = 86400000
+}
+`, partitions)
+}
+
+func TestAccOfflineKafkaTopicResource(t *testing.T) {
+	s, layoutID := startOfflineKafkaPortal(t)
+
+	clusterName := "vtb_kafka_instance.test"
+	resourceName := "vtb_kafka_topic.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckOrdersDeleted(s),
+			testAccCheckAction(s, "kafka_delete_topics", func(call fake.ActionCall) error {
+				topics := call.Attrs["topics"].([]interface{})
+				if len(topics) != 1 || topics[0] != "external" {
+					return fmt.Errorf("unexpected kafka_delete_topics attrs: %v", call.Attrs)
+				}
+				return nil
+			}),
+		),
+		Steps: []resource.TestStep{
+			// в режиме shared кластер не видит топик отдельного ресурса
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaTopicConfig(layoutID, "shared", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterName, "topics.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "partitions", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment_size_mb", "1024"),
+					resource.TestCheckResourceAttr(resourceName, "compression_type", "default"),
+					testAccCheckActions(s, "create", "kafka_create_topics", "kafka_create_topics"),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineKafkaTopicConfig(layoutID, "shared", 3),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(clusterName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "partitions", "3"),
+					testAccCheckAction(s, "kafka_edit_topics_release", func(call fake.ActionCall) error {
+						changes := call.Attrs["changes"].([]interface{})
+						change := changes[0].(map[string]interface{})
+						params := change["parameters"].(map[string]interface{})
+						if len(changes) != 1 || params["partitions_number"] != float64(3) {
+							return fmt.Errorf("unexpected kafka_edit_topics_release attrs: %v", call.Attrs)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attrs := state.RootModule().Resources[resourceName].Primary.Attributes
+					return attrs["kafka_order_id"] + "/" + attrs["name"], nil
+				},
+				ImportStateVerifyIgnore: []string{"allow_topic_recreate"},
+			},
+			// в режиме exclusive кластер забирает топик себе и планирует его удаление
+			{
+				Config:             offlineProviderConfig + testAccOfflineKafkaTopicConfig(layoutID, "exclusive", 3),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(clusterName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(clusterName, "topics_ownership", "exclusive"),
+					testAccCheckActions(s,
+						"create", "kafka_create_topics", "kafka_create_topics", "kafka_edit_topics_release",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/fake"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func testAccOfflineServiceAccountConfig(roles, rotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "vtb_service_account" "test" {
+	title            = "ci-deployer"
+	roles            = %s
+	rotation_trigger = "%s"
+}
+`, roles, rotationTrigger)
+}
+
+// testAccCheckServiceAccountKey сравнивает ключ в state с ключом аккаунта на портале
+func testAccCheckServiceAccountKey(s *fake.Server, resourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource '%s' not found in state", resourceName)
+		}
+		attrs := rs.Primary.Attributes
+
+		account, ok := s.ServiceAccount(attrs["project"], attrs["name"])
+		if !ok {
+			return fmt.Errorf("service account '%s' not found on portal", attrs["name"])
+		}
+		if attrs["client_id"] != account.ApiKey.ClientId || attrs["client_secret"] != account.ApiKey.ClientSecret {
+			return fmt.Errorf("state api key doesn't match portal api key of '%s'", account.Name)
+		}
+		return nil
+	}
+}
+
+// testAccCheckServiceAccountName сохраняет имя аккаунта из state в name
+func testAccCheckServiceAccountName(resourceName string, name *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		*name = state.RootModule().Resources[resourceName].Primary.Attributes["name"]
+		return nil
+	}
+}
+
+func TestAccOfflineServiceAccountResource(t *testing.T) {
+	s := startOfflinePortal(t)
+
+	resourceName := "vtb_service_account.test"
+	var name string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: offlineProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			for _, request := range s.Requests() {
+				if request.Method == "DELETE" {
+					return nil
+				}
+			}
+			return fmt.Errorf("service account wasn't deleted")
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: offlineProviderConfig + testAccOfflineServiceAccountConfig(
+					`["roles/viewer"]`, "2026-10-01",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project", fake.ProjectName),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					testAccCheckServiceAccountKey(s, resourceName),
+					testAccCheckServiceAccountName(resourceName, &name),
+				),
+			},
+			{
+				Config: offlineProviderConfig + testAccOfflineServiceAccountConfig(
+					`["roles/admin", "roles/viewer"]`, "2026-10-01",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					testAccCheckServiceAccountKey(s, resourceName),
+				),
+			},
+			{
+				// ротация пересоздает аккаунт, новый ключ выдается при создании
+				Config: offlineProviderConfig + testAccOfflineServiceAccountConfig(
+					`["roles/admin", "roles/viewer"]`, "2026-11-01",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("client_id")),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("client_secret")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "2026-11-01"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					testAccCheckServiceAccountKey(s, resourceName),
+					func(state *terraform.State) error {
+						if _, ok := s.ServiceAccount(fake.ProjectName, name); ok {
+							return fmt.Errorf("service account '%s' wasn't deleted on rotation", name)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attrs := state.RootModule().Resources[resourceName].Primary.Attributes
+					return attrs["project"] + "/" + attrs["name"], nil
+				},
+				ImportStateVerifyIgnore: []string{"client_secret", "rotation_trigger"},
+			},
+		},
+	})
+}
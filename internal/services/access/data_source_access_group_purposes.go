@@ -0,0 +1,141 @@
+package access
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &AccessGroupPurposesDataSource{}
+)
+
+type AccessGroupPurposesDataSource struct {
+	client *client.CloudClient
+}
+
+func NewAccessGroupPurposesDataSource() datasource.DataSource {
+	return &AccessGroupPurposesDataSource{}
+}
+
+func (d AccessGroupPurposesDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_access_group_purposes"
+}
+
+func (d *AccessGroupPurposesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type AccessGroupPurposesModel struct {
+	Codes    []string                  `tfsdk:"codes"`
+	Purposes []AccessGroupPurposeModel `tfsdk:"purposes"`
+}
+
+type AccessGroupPurposeModel struct {
+	ID          types.String `tfsdk:"id"`
+	Code        types.String `tfsdk:"code"`
+	Description types.String `tfsdk:"description"`
+	Postprefix  types.String `tfsdk:"postprefix"`
+}
+
+func (d AccessGroupPurposesDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Назначения групп доступа, доступные в проекте провайдера",
+		Attributes: map[string]schema.Attribute{
+			"codes": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Коды назначений",
+			},
+			"purposes": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Назначения групп доступа",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор назначения",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код назначения. Пример: compute",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Описание назначения",
+						},
+						"postprefix": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Постфикс имени групп с этим назначением",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AccessGroupPurposesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data AccessGroupPurposesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	purposes, err := sources.GetPurposes(d.client.Creds, "", d.client.ProjectName, "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't get access group purposes of project '%s'.\nError: %s", d.client.ProjectName, err.Error()),
+		)
+		return
+	}
+
+	data.Codes = []string{}
+	data.Purposes = []AccessGroupPurposeModel{}
+	for _, purpose := range purposes {
+		data.Codes = append(data.Codes, purpose.Code)
+		data.Purposes = append(data.Purposes, AccessGroupPurposeModel{
+			ID:          types.StringValue(purpose.ID),
+			Code:        types.StringValue(purpose.Code),
+			Description: types.StringValue(purpose.Description),
+			Postprefix:  types.StringValue(purpose.Postprefix),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,248 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &AccessGroupsDataSource{}
+)
+
+type AccessGroupsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewAccessGroupsDataSource() datasource.DataSource {
+	return &AccessGroupsDataSource{}
+}
+
+func (d AccessGroupsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_access_groups"
+}
+
+func (d *AccessGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type AccessGroupsModel struct {
+	Domain       types.String           `tfsdk:"domain"`
+	AccountsType types.String           `tfsdk:"accounts_type"`
+	Purpose      types.String           `tfsdk:"purpose"`
+	NameRegex    types.String           `tfsdk:"name_regex"`
+	Names        []string               `tfsdk:"names"`
+	Groups       []AccessGroupDataModel `tfsdk:"groups"`
+}
+
+type AccessGroupDataModel struct {
+	Name         types.String             `tfsdk:"name"`
+	FullName     types.String             `tfsdk:"full_name"`
+	Description  types.String             `tfsdk:"description"`
+	Domain       types.String             `tfsdk:"domain"`
+	GroupDN      types.String             `tfsdk:"group_dn"`
+	Purpose      types.String             `tfsdk:"purpose"`
+	AccountsType types.String             `tfsdk:"accounts_type"`
+	Members      []AccessGroupUserModelV1 `tfsdk:"members"`
+}
+
+func (d AccessGroupsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Группы доступа AD проекта провайдера",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Домен групп. Пример: corp.dev.vtb",
+			},
+			"accounts_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Тип учетных записей группы",
+				Validators: []validator.String{
+					stringvalidator.OneOf("personal", "service-accounts"),
+				},
+			},
+			"purpose": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Код назначения группы. Доступные коды возвращает `vtb_access_group_purposes`",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Регулярное выражение для фильтрации по имени группы",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Имена найденных групп",
+			},
+			"groups": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Найденные группы доступа",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Имя группы для использования в `access`",
+						},
+						"full_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Полное имя группы, как в `vtb_access_group_instance`",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Описание группы",
+						},
+						"domain": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Домен группы",
+						},
+						"group_dn": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Distinguished name группы в AD",
+						},
+						"purpose": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код назначения группы",
+						},
+						"accounts_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Тип учетных записей группы",
+						},
+						"members": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Участники группы",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed: true,
+									},
+									"username": schema.StringAttribute{
+										Computed: true,
+									},
+									"name": schema.StringAttribute{
+										Computed: true,
+									},
+									"email": schema.StringAttribute{
+										Computed: true,
+									},
+									"unique_name": schema.StringAttribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AccessGroupsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data AccessGroupsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if data.NameRegex.ValueString() != "" {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid regular expression", err.Error())
+			return
+		}
+	}
+
+	groups, err := sources.GetAccessGroups(d.client.Creds, d.client.ProjectName, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't get access groups of project '%s'.\nError: %s", d.client.ProjectName, err.Error()),
+		)
+		return
+	}
+
+	data.Names = []string{}
+	data.Groups = []AccessGroupDataModel{}
+	for _, group := range groups {
+		if group.IsDeleted {
+			continue
+		}
+		if accountsType := data.AccountsType.ValueString(); accountsType != "" && group.AccountsType != accountsType {
+			continue
+		}
+		if purpose := data.Purpose.ValueString(); purpose != "" && group.Purpose.Code != purpose {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(group.Name) {
+			continue
+		}
+
+		members := []AccessGroupUserModelV1{}
+		for _, user := range group.Users {
+			members = append(members, AccessGroupUserModelV1{
+				ID:         types.StringValue(user.ID),
+				Username:   types.StringValue(user.Username),
+				Name:       types.StringValue(user.Name),
+				Email:      types.StringValue(user.Email),
+				UniqueName: types.StringValue(user.UniqueName),
+			})
+		}
+
+		data.Names = append(data.Names, group.Name)
+		data.Groups = append(data.Groups, AccessGroupDataModel{
+			Name:         types.StringValue(group.Name),
+			FullName:     types.StringValue(group.Name),
+			Description:  types.StringValue(group.Description),
+			Domain:       types.StringValue(group.Domain),
+			GroupDN:      types.StringValue(group.GroupDn),
+			Purpose:      types.StringValue(group.Purpose.Code),
+			AccountsType: types.StringValue(group.AccountsType),
+			Members:      members,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
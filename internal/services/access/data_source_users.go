@@ -0,0 +1,247 @@
+package access
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &UsersDataSource{}
+)
+
+type UsersDataSource struct {
+	client *client.CloudClient
+}
+
+func NewUsersDataSource() datasource.DataSource {
+	return &UsersDataSource{}
+}
+
+func (d UsersDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *UsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type UsersModel struct {
+	Domain      types.String             `tfsdk:"domain"`
+	Queries     []string                 `tfsdk:"queries"`
+	Group       types.String             `tfsdk:"group"`
+	UniqueNames []string                 `tfsdk:"unique_names"`
+	Emails      []string                 `tfsdk:"emails"`
+	Unresolved  []string                 `tfsdk:"unresolved"`
+	Users       []AccessGroupUserModelV1 `tfsdk:"users"`
+}
+
+func (d UsersDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Поиск пользователей домена по списку запросов и/или по группе доступа. " +
+			"Ненайденные и неоднозначные запросы выводятся предупреждениями и попадают в `unresolved`",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Домен пользователей. Пример: corp.dev.vtb",
+			},
+			"queries": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Строки поиска: email, username@domain или часть имени. " +
+					"Каждая строка должна находить ровно одного пользователя",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+					listvalidator.AtLeastOneOf(path.MatchRoot("group")),
+				},
+			},
+			"group": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Полное имя группы доступа. Без `queries` возвращаются все участники группы, " +
+					"вместе с `queries` поиск ведется только среди участников группы",
+			},
+			"unique_names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Уникальные имена найденных пользователей",
+			},
+			"emails": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Email найденных пользователей",
+			},
+			"unresolved": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Запросы, по которым не найден ровно один пользователь",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Найденные пользователи",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"email": schema.StringAttribute{
+							Computed: true,
+						},
+						"unique_name": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d UsersDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data UsersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.UniqueNames = []string{}
+	data.Emails = []string{}
+	data.Unresolved = []string{}
+	data.Users = []AccessGroupUserModelV1{}
+
+	found := map[string]bool{}
+	appendUser := func(user sources.User) {
+		if found[user.UniqueName] {
+			return
+		}
+		found[user.UniqueName] = true
+		data.UniqueNames = append(data.UniqueNames, user.UniqueName)
+		data.Emails = append(data.Emails, user.Email)
+		data.Users = append(data.Users, AccessGroupUserModelV1{
+			ID:         types.StringValue(user.ID),
+			Username:   types.StringValue(user.Username),
+			Name:       types.StringValue(user.Name),
+			Email:      types.StringValue(user.Email),
+			UniqueName: types.StringValue(user.UniqueName),
+		})
+	}
+
+	if len(data.Queries) == 0 {
+		users, err := sources.GetUsersByGroup(d.client.Creds, data.Group.ValueString(), d.client.ProjectName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("group"),
+				consts.READ_RES_FAIL,
+				fmt.Sprintf("Can't get users of group '%s'.\nError: %s", data.Group.ValueString(), err.Error()),
+			)
+			return
+		}
+		for _, user := range users {
+			appendUser(user)
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	for _, query := range data.Queries {
+		var users []sources.User
+		var err error
+		if data.Group.ValueString() != "" {
+			users, err = sources.FindUsersByGroupAndQuery(
+				d.client.Creds,
+				query,
+				data.Group.ValueString(),
+				d.client.ProjectName,
+				data.Domain.ValueString(),
+			)
+		} else {
+			users, err = sources.GetUsersByQuery(
+				d.client.Creds,
+				query,
+				d.client.ProjectName,
+				data.Domain.ValueString(),
+			)
+		}
+		if err != nil && !errors.Is(err, sources.ErrUserNotFound) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("queries"),
+				consts.READ_RES_FAIL,
+				fmt.Sprintf("Can't find users by query '%s'.\nError: %s", query, err.Error()),
+			)
+			return
+		}
+
+		switch len(users) {
+		case 1:
+			appendUser(users[0])
+		case 0:
+			data.Unresolved = append(data.Unresolved, query)
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("queries"),
+				"User not found",
+				fmt.Sprintf("Can't find user by query '%s' in domain '%s'", query, data.Domain.ValueString()),
+			)
+		default:
+			var usernames []string
+			for _, user := range users {
+				usernames = append(usernames, user.Username)
+			}
+			data.Unresolved = append(data.Unresolved, query)
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("queries"),
+				"User query is ambiguous",
+				fmt.Sprintf(
+					"Query '%s' matches more then 1 user: [%s]. Please specify more details in your query",
+					query, strings.Join(usernames, ", "),
+				),
+			)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -90,6 +90,7 @@ type AgentOrchestrationResourceModel struct {
 	ChannelURL       types.String    `tfsdk:"channel_url"`
 	AgentInstance    types.String    `tfsdk:"agent_instance"`
 	CountOfExecutors types.Int64     `tfsdk:"count_of_executors"`
+	PlannedActions   types.List      `tfsdk:"planned_actions"`
 }
 
 type SferaAgentModel struct {
@@ -302,6 +303,7 @@ func (r AgentOrchestrationResource) Schema(
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -318,6 +320,9 @@ func (r *AgentOrchestrationResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "app", "agent_orchestration")
 	resp.Diagnostics.Append(checkOrderIsDeleted.Diagnostics...)
 
@@ -333,6 +338,16 @@ func (r *AgentOrchestrationResource) ModifyPlan(
 		return
 	}
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state AgentOrchestrationResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r AgentOrchestrationResource) ImportState(
@@ -340,7 +355,11 @@ func (r AgentOrchestrationResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "agent_orchestration"}},
+		req, resp,
+	)
 }
 
 func (r AgentOrchestrationResource) Create(
@@ -442,6 +461,7 @@ func (r AgentOrchestrationResource) Create(
 	plan.AgentVersion = types.StringValue(agentConfig.Version)
 	plan.ChannelURL = types.StringValue(agentConfig.ChannelURL)
 	plan.CountOfExecutors = types.Int64Value(agentConfig.CountOfExecutors)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -572,6 +592,7 @@ func (r AgentOrchestrationResource) Read(
 	state.AgentVersion = types.StringValue(agentConfig.Version)
 	state.ChannelURL = types.StringValue(agentConfig.ChannelURL)
 	state.CountOfExecutors = types.Int64Value(agentConfig.CountOfExecutors)
+	state.PlannedActions = utils.PriorPlannedActions(ctx, req.State)
 
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
@@ -594,6 +615,17 @@ func (r AgentOrchestrationResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetAgentOrchestrationOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -620,7 +652,7 @@ func (r AgentOrchestrationResource) Update(
 	labelChanged := plan.Label != state.Label
 	mountChanged := utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts)
 	flavorChanged := plan.Flavor != state.Flavor
-	financialProjectChanged := plan.FinancialProject.Equal(state.FinancialProject)
+	financialProjectChanged := !plan.FinancialProject.Equal(state.FinancialProject)
 
 	// change label
 	if labelChanged {
@@ -723,6 +755,28 @@ func (r AgentOrchestrationResource) Delete(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *AgentOrchestrationResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("two_layer_resize_vm_agent_orchestration", "Изменение конфигурации ВМ с перезагрузкой")
+	}
+	return actions
+}
+
 func changeFlavorAgentOrchestration(
 	order *orders.AgentOrchestration,
 	plan *AgentOrchestrationResourceModel,
@@ -109,6 +109,7 @@ type AirflowClusterResourceModel struct {
 
 	UpdateMode       types.String `tfsdk:"update_product_mode"`
 	FinancialProject types.String `tfsdk:"financial_project"`
+	PlannedActions   types.List   `tfsdk:"planned_actions"`
 }
 
 func (r AirflowClusterResource) Schema(
@@ -438,6 +439,7 @@ func (r AirflowClusterResource) Schema(
 				Description:         "Источник финансирования для заказа.",
 				MarkdownDescription: "Источник финансирования для заказа.",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -447,7 +449,11 @@ func (r AirflowClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "airflow"}},
+		req, resp,
+	)
 }
 
 func (r AirflowClusterResource) ModifyPlan(
@@ -462,6 +468,9 @@ func (r AirflowClusterResource) ModifyPlan(
 	var plan, state AirflowClusterResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -530,6 +539,12 @@ func (r AirflowClusterResource) ModifyPlan(
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsOrderDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r AirflowClusterResource) Create(
@@ -644,6 +659,7 @@ func (r AirflowClusterResource) Create(
 	plan.OrderID = types.StringValue(order.GetOrder().ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.BuildVersion = types.StringValue(orderItem.Data.Build.SetupVersion)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -708,7 +724,11 @@ func (r AirflowClusterResource) Read(
 		)
 		return
 	}
-	airflowConfig := airflowParentItem.Data.Config.(entities.AirflowClusterItemConfig)
+	airflowConfig, err := entities.ItemConfig[entities.AirflowClusterItemConfig](airflowParentItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	airflowVMs, err := order.GetAirflowVMItems()
 	if err != nil {
@@ -718,7 +738,11 @@ func (r AirflowClusterResource) Read(
 		)
 		return
 	}
-	vmConfig := airflowVMs[0].Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&airflowVMs[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	schedulerItems, err := order.GetAirflowItemsByType("scheduler")
 	if err != nil {
@@ -728,7 +752,12 @@ func (r AirflowClusterResource) Read(
 		)
 		return
 	}
-	schedulerFlavor := schedulerItems[0].Data.Config.(entities.VMItemConfig).Flavor
+	schedulerConfig, err := entities.ItemConfig[entities.VMItemConfig](&schedulerItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	schedulerFlavor := schedulerConfig.Flavor
 	schedulerCount := len(schedulerItems)
 
 	workerItems, err := order.GetAirflowItemsByType("worker")
@@ -739,7 +768,12 @@ func (r AirflowClusterResource) Read(
 		)
 		return
 	}
-	workerFlavor := workerItems[0].Data.Config.(entities.VMItemConfig).Flavor
+	workerConfig, err := entities.ItemConfig[entities.VMItemConfig](&workerItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	workerFlavor := workerConfig.Flavor
 	workerCount := len(workerItems)
 
 	webserverItems, err := order.GetAirflowItemsByType("webserver")
@@ -750,7 +784,12 @@ func (r AirflowClusterResource) Read(
 		)
 		return
 	}
-	webserverFlavor := webserverItems[0].Data.Config.(entities.VMItemConfig).Flavor
+	webserverConfig, err := entities.ItemConfig[entities.VMItemConfig](&webserverItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	webserverFlavor := webserverConfig.Flavor
 	webserverCount := len(webserverItems)
 
 	prefix := "one_dc"
@@ -869,6 +908,7 @@ func (r AirflowClusterResource) Read(
 			RabbitMQCertCN: types.StringValue(airflowConfig.RabbitMQConfig.RabbitMQCertCN),
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -960,6 +1000,20 @@ func (r AirflowClusterResource) Update(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetAirflowClusterOrder(
 		r.client.Creds,
@@ -1101,6 +1155,47 @@ func (r AirflowClusterResource) Update(
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *AirflowClusterResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if plan.DeployGrants != nil && state.DeployGrants != nil &&
+		!plan.DeployGrants.AirflowDeploy.Equal(state.DeployGrants.AirflowDeploy) {
+		actions.Add("airflow_change_deploy_group", "Изменение групп деплоя")
+	}
+
+	if !utils.IsADLogonGrantsEqual(plan.WebConsoleGrants, state.WebConsoleGrants) {
+		actions.Add("airflow_change_web_access", "Изменение групп доступа к web-консоли")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("airflow_expand_mount_point", "Расширение точки монтирования")
+	}
+
+	if plan.FlavorScheduler != state.FlavorScheduler ||
+		plan.FlavorWorker != state.FlavorWorker ||
+		plan.FlavorWebserver != state.FlavorWebserver {
+		actions.AddDisruptive("airflow_vertical_scaling", "Изменение конфигурации ВМ кластера с перезапуском Airflow")
+	}
+
+	if !plan.LayoutID.Equal(state.LayoutID) {
+		actions.Add("airflow_add_node", "Горизонтальное масштабирование кластера")
+	}
+
+	if !plan.UpdateMode.IsNull() && plan.UpdateMode.ValueString() == "latest" {
+		actions.AddDisruptive("airflow_upgrade_product", "Обновление версии продукта с перезапуском Airflow")
+	}
+
+	if !plan.PostgreSQLConfig.DBPassword.Equal(state.PostgreSQLConfig.DBPassword) {
+		actions.Add("airflow_change_db_password", "Изменение пароля пользователя БД")
+	}
+	return actions
+}
+
 func (r AirflowClusterResource) horizontalScaling(
 	order *orders.AirflowCluster,
 	plan *AirflowClusterResourceModel,
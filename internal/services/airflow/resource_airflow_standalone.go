@@ -111,6 +111,7 @@ type AirflowStandaloneResourceModel struct {
 
 	UpdateMode       types.String `tfsdk:"update_product_mode"`
 	FinancialProject types.String `tfsdk:"financial_project"`
+	PlannedActions   types.List   `tfsdk:"planned_actions"`
 }
 
 type DeployGrantsModel struct {
@@ -399,6 +400,7 @@ func (r AirflowStandaloneResource) Schema(
 				Description:         "Источник финансирования для заказа.",
 				MarkdownDescription: "Источник финансирования для заказа.",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -408,7 +410,11 @@ func (r AirflowStandaloneResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "airflow"}},
+		req, resp,
+	)
 }
 
 func (r AirflowStandaloneResource) ModifyPlan(
@@ -423,6 +429,9 @@ func (r AirflowStandaloneResource) ModifyPlan(
 	var plan, state AirflowStandaloneResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -498,6 +507,12 @@ func (r AirflowStandaloneResource) ModifyPlan(
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsOrderDeleted.IsDeleted {
+		actions = plannedStandaloneActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r AirflowStandaloneResource) Create(
@@ -586,6 +601,7 @@ func (r AirflowStandaloneResource) Create(
 	plan.OrderID = types.StringValue(order.GetOrder().ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.BuildVersion = types.StringValue(orderItem.Data.Build.SetupVersion)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -650,7 +666,11 @@ func (r AirflowStandaloneResource) Read(
 		)
 		return
 	}
-	airflowConfig := airflowParentItem.Data.Config.(entities.AirflowStandaloneItemConfig)
+	airflowConfig, err := entities.ItemConfig[entities.AirflowStandaloneItemConfig](airflowParentItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	airflowVM, err := order.GetAirflowVMItem()
 	if err != nil {
@@ -660,7 +680,11 @@ func (r AirflowStandaloneResource) Read(
 		)
 		return
 	}
-	vmConfig := airflowVM.Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](airflowVM)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	appMount, err := order.GetAirflowExtraMount("/app")
 	if err != nil {
@@ -743,6 +767,7 @@ func (r AirflowStandaloneResource) Read(
 			DBOrderID:  types.StringValue(order.Attrs.PostgresqlConfig.DBOrderID),
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -834,6 +859,20 @@ func (r AirflowStandaloneResource) Update(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedStandaloneActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetAirflowStandaloneOrder(
 		r.client.Creds,
@@ -1058,6 +1097,45 @@ func (r *AirflowStandaloneResource) compareADRoles(
 	return deletedRoles, changedRoles, addedRoles
 }
 
+// plannedStandaloneActions действия портала в порядке их вызова в Update
+func plannedStandaloneActions(state, plan *AirflowStandaloneResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !reflect.DeepEqual(plan.Access, state.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if plan.DeployGrants != nil && state.DeployGrants != nil &&
+		!plan.DeployGrants.AirflowDeploy.Equal(state.DeployGrants.AirflowDeploy) {
+		actions.Add("airflow_change_deploy_group", "Изменение групп деплоя")
+	}
+
+	if !utils.IsADLogonGrantsEqual(plan.WebConsoleGrants, state.WebConsoleGrants) {
+		actions.Add("airflow_change_web_access", "Изменение групп доступа к web-консоли")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("airflow_vertical_scaling", "Изменение конфигурации ВМ с перезапуском Airflow")
+	}
+
+	if !plan.UpdateMode.IsNull() && plan.UpdateMode.ValueString() == "latest" {
+		actions.AddDisruptive("airflow_upgrade_product", "Обновление версии продукта с перезапуском Airflow")
+	}
+
+	if !plan.PostgreSQLConfig.DBPassword.Equal(state.PostgreSQLConfig.DBPassword) {
+		actions.Add("airflow_change_db_password", "Изменение пароля пользователя БД")
+	}
+	return actions
+}
+
 func (r AirflowStandaloneResource) expandMountPoint(
 	order *orders.AirflowStandalone,
 	plan *AirflowStandaloneResourceModel,
@@ -0,0 +1,181 @@
+package astra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &ComputeSnapshotsDataSource{}
+)
+
+type ComputeSnapshotsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewComputeSnapshotsDataSource() datasource.DataSource {
+	return &ComputeSnapshotsDataSource{}
+}
+
+func (d ComputeSnapshotsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_compute_snapshots"
+}
+
+func (d *ComputeSnapshotsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type ComputeSnapshotsModel struct {
+	VMOrderID types.String           `tfsdk:"vm_order_id"`
+	Names     []string               `tfsdk:"names"`
+	Snapshots []ComputeSnapshotModel `tfsdk:"snapshots"`
+}
+
+type ComputeSnapshotModel struct {
+	ItemID     types.String `tfsdk:"item_id"`
+	Name       types.String `tfsdk:"name"`
+	State      types.String `tfsdk:"state"`
+	DeleteDate types.String `tfsdk:"delete_date"`
+	Volumes    []string     `tfsdk:"volumes"`
+}
+
+func (d ComputeSnapshotsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Снимки вычислительного экземпляра `vtb_compute_instance`",
+		Attributes: map[string]schema.Attribute{
+			"vm_order_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Идентификатор заказа вычислительного экземпляра",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Имена снимков",
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Снимки ВМ, отсортированные по имени",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор сущности снимка в заказе ВМ",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Имя снимка",
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Состояние снимка на портале",
+						},
+						"delete_date": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Дата автоматического удаления снимка",
+						},
+						"volumes": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Идентификаторы дисков, вошедших в снимок",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ComputeSnapshotsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ComputeSnapshotsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetComputeOrder(d.client.Creds, d.client.ProjectName, data.VMOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get compute order with order_id '%s'.\nError: %s",
+				data.VMOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	snapshots, err := order.GetSnapshots()
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, fmt.Sprintf("Can't get vm snapshots.\nError: %s", err.Error()))
+		return
+	}
+
+	data.Names = []string{}
+	data.Snapshots = []ComputeSnapshotModel{}
+	for _, snapshot := range snapshots {
+		config, err := entities.ItemConfig[entities.SnapshotItemConfig](&snapshot)
+		if err != nil {
+			resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+			return
+		}
+		deleteDate, _, _ := strings.Cut(config.DeleteDate, "T")
+
+		volumes := config.Volumes
+		if volumes == nil {
+			volumes = []string{}
+		}
+		data.Snapshots = append(data.Snapshots, ComputeSnapshotModel{
+			ItemID:     types.StringValue(snapshot.ID),
+			Name:       types.StringValue(config.Name),
+			State:      types.StringValue(snapshot.Data.State),
+			DeleteDate: types.StringValue(deleteDate),
+			Volumes:    volumes,
+		})
+	}
+	sort.Slice(data.Snapshots, func(i, j int) bool {
+		return data.Snapshots[i].Name.ValueString() < data.Snapshots[j].Name.ValueString()
+	})
+	for _, snapshot := range data.Snapshots {
+		data.Names = append(data.Names, snapshot.Name.ValueString())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -75,18 +75,23 @@ func (r *ComputeResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 type ComputeResourceModel struct {
-	Core             core.CoreModel                    `tfsdk:"core"`
-	Flavor           flavor.FlavorModel                `tfsdk:"flavor"`
-	Image            common.ImageStandardModel         `tfsdk:"image"`
-	Lifetime         types.Int64                       `tfsdk:"lifetime"`
-	Label            types.String                      `tfsdk:"label"`
-	OrderID          types.String                      `tfsdk:"order_id"`
-	ItemID           types.String                      `tfsdk:"item_id"`
-	Access           map[string][]string               `tfsdk:"access"`
-	ExtraMounts      map[string]common.ExtraMountModel `tfsdk:"extra_mounts"`
-	Hostname         types.String                      `tfsdk:"hostname"`
-	FixedIP          types.String                      `tfsdk:"fixed_ip"`
-	FinancialProject types.String                      `tfsdk:"financial_project"`
+	Core                core.CoreModel                    `tfsdk:"core"`
+	Flavor              flavor.FlavorModel                `tfsdk:"flavor"`
+	Image               common.ImageStandardModel         `tfsdk:"image"`
+	Lifetime            types.Int64                       `tfsdk:"lifetime"`
+	Label               types.String                      `tfsdk:"label"`
+	OrderID             types.String                      `tfsdk:"order_id"`
+	ItemID              types.String                      `tfsdk:"item_id"`
+	Access              map[string][]string               `tfsdk:"access"`
+	ExtraMounts         map[string]common.ExtraMountModel `tfsdk:"extra_mounts"`
+	Hostname            types.String                      `tfsdk:"hostname"`
+	FixedIP             types.String                      `tfsdk:"fixed_ip"`
+	FinancialProject    types.String                      `tfsdk:"financial_project"`
+	PowerState          types.String                      `tfsdk:"power_state"`
+	RebootTrigger       types.String                      `tfsdk:"reboot_trigger"`
+	PlannedActions      types.List                        `tfsdk:"planned_actions"`
+	MaintenanceWindow   *common.MaintenanceWindowModel    `tfsdk:"maintenance_window"`
+	MaintenanceOverride types.Bool                        `tfsdk:"maintenance_override"`
 }
 
 func (r *ComputeResource) Schema(
@@ -220,6 +225,26 @@ func (r *ComputeResource) Schema(
 				Description:         "Источник финансирования для заказа.",
 				MarkdownDescription: "Источник финансирования для заказа.",
 			},
+			"power_state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("on"),
+				Description:         "Состояние ВМ (on/off). При значении off ВМ выключается штатно.",
+				MarkdownDescription: "Состояние ВМ (`on`/`off`). При значении `off` ВМ выключается штатно.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("on", "off"),
+				},
+			},
+			"reboot_trigger": schema.StringAttribute{
+				Optional: true,
+				Description: "Произвольное значение, при изменении которого ВМ перезагружается " +
+					"(например, идентификатор установленного обновления).",
+				MarkdownDescription: "Произвольное значение, при изменении которого ВМ перезагружается " +
+					"(например, идентификатор установленного обновления).",
+			},
+			"planned_actions":      common.PlannedActionsSchema,
+			"maintenance_window":   common.MaintenanceWindowSchema,
+			"maintenance_override": common.MaintenanceOverrideSchema,
 		},
 	}
 }
@@ -236,6 +261,9 @@ func (r *ComputeResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	validateRolesDiags := utils.ValidateAccessRolesV1(
 		r.client,
 		"vm:linux",
@@ -258,6 +286,28 @@ func (r *ComputeResource) ModifyPlan(
 		return
 	}
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state ComputeResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// выключаемую в этом же плане ВМ перезагружаем до остановки, запрещаем только
+		// перезагрузку уже выключенной ВМ, которая не запускается
+		if rebootRequested(&state, &plan) &&
+			state.PowerState.ValueString() == "off" && plan.PowerState.ValueString() == "off" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reboot_trigger"),
+				consts.MODIFY_PLAN_FAIL,
+				"Can't reboot virtual machine with power_state 'off'. Set power_state to 'on' to reboot it.",
+			)
+			return
+		}
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r *ComputeResource) ImportState(
@@ -265,7 +315,11 @@ func (r *ComputeResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "vm", Provider: ""}},
+		req, resp,
+	)
 }
 
 func (r *ComputeResource) Create(
@@ -363,12 +417,25 @@ func (r *ComputeResource) Create(
 		return
 	}
 
-	config := parentItem.Data.Config.(entities.VMItemConfig)
+	if plan.PowerState.ValueString() == "off" {
+		err = order.StopSoft()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("power_state"), consts.CREATE_RES_FAIL, err.Error())
+			return
+		}
+	}
+
+	config, err := entities.ItemConfig[entities.VMItemConfig](parentItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(parentItem.ID)
 	plan.Hostname = types.StringValue(config.Hostname)
 	plan.FixedIP = types.StringValue(config.DefaultNic.Addresses[0].Address)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -409,7 +476,11 @@ func (r *ComputeResource) Read(
 		)
 		return
 	}
-	config := item.Data.Config.(entities.VMItemConfig)
+	config, err := entities.ItemConfig[entities.VMItemConfig](item)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	appExtraMount, err := order.GetExtraMount("/app")
 	if err != nil {
@@ -456,8 +527,17 @@ func (r *ComputeResource) Read(
 			ADIntegration: types.BoolValue(order.Attrs.ADIntegration),
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PowerState:       types.StringValue("on"),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
+	}
+	if item.Data.State == "off" {
+		state.PowerState = types.StringValue("off")
 	}
 
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("reboot_trigger"), &state.RebootTrigger)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_window"), &state.MaintenanceWindow)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_override"), &state.MaintenanceOverride)...)
+
 	var lifetime types.Int64
 	req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)
 	if !lifetime.IsNull() {
@@ -478,6 +558,17 @@ func (r *ComputeResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetComputeOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -503,6 +594,15 @@ func (r *ComputeResource) Update(
 	flavorChanged := plan.Flavor != state.Flavor
 	accessChanged := !reflect.DeepEqual(plan.Access, state.Access)
 
+	// ВМ включается до остальных изменений, а выключается после них
+	powerStateChanged := !plan.PowerState.Equal(state.PowerState)
+	if powerStateChanged && plan.PowerState.ValueString() == "on" {
+		changePowerState(order, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if labelChanged {
 		utils.ChangeOrderLabel(order, plan.Label.ValueString(), resp)
 	}
@@ -527,6 +627,21 @@ func (r *ComputeResource) Update(
 		return
 	}
 
+	if rebootRequested(&state, &plan) {
+		err = order.Reboot()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("reboot_trigger"), consts.UPDATE_RES_FAIL, err.Error())
+			return
+		}
+	}
+
+	if powerStateChanged && plan.PowerState.ValueString() == "off" {
+		changePowerState(order, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -688,6 +803,68 @@ func (r ComputeResource) changeAccess(
 	return
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *ComputeResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+	powerStateChanged := !plan.PowerState.Equal(state.PowerState)
+
+	if powerStateChanged && plan.PowerState.ValueString() == "on" {
+		actions.Add("start_vm", "Включение ВМ")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("resize_vm", "Изменение конфигурации ВМ с перезагрузкой")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !reflect.DeepEqual(plan.Access, state.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if rebootRequested(state, plan) {
+		actions.AddDisruptive("reset_vm", "Перезагрузка ВМ")
+	}
+
+	if powerStateChanged && plan.PowerState.ValueString() == "off" {
+		actions.AddDisruptive("stop_vm_soft", "Выключение ВМ")
+	}
+	return actions
+}
+
+// rebootRequested ВМ перезагружается, когда reboot_trigger получает новое значение.
+// Удаление значения перезагрузку не вызывает
+func rebootRequested(state, plan *ComputeResourceModel) bool {
+	return !plan.RebootTrigger.IsNull() && !plan.RebootTrigger.IsUnknown() &&
+		!plan.RebootTrigger.Equal(state.RebootTrigger)
+}
+
+func changePowerState(
+	order *orders.Compute,
+	plan *ComputeResourceModel,
+	diags *diag.Diagnostics,
+) {
+	var err error
+	if plan.PowerState.ValueString() == "off" {
+		err = order.StopSoft()
+	} else {
+		err = order.Start()
+	}
+	if err != nil {
+		diags.AddAttributeError(path.Root("power_state"), consts.UPDATE_RES_FAIL, err.Error())
+	}
+}
+
 func changeFlavor(
 	order *orders.Compute,
 	plan *ComputeResourceModel,
@@ -0,0 +1,412 @@
+package astra
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ComputeSnapshotResource{}
+	_ resource.ResourceWithImportState = &ComputeSnapshotResource{}
+	_ resource.ResourceWithModifyPlan  = &ComputeSnapshotResource{}
+)
+
+type ComputeSnapshotResource struct {
+	client *client.CloudClient
+}
+
+func NewComputeSnapshotResource() resource.Resource {
+	return &ComputeSnapshotResource{}
+}
+
+func (r ComputeSnapshotResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_compute_snapshot"
+}
+
+func (r *ComputeSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type ComputeSnapshotResourceModel struct {
+	VMOrderID     types.String `tfsdk:"vm_order_id"`
+	Name          types.String `tfsdk:"name"`
+	DeleteDate    types.String `tfsdk:"delete_date"`
+	Volumes       types.List   `tfsdk:"volumes"`
+	ItemID        types.String `tfsdk:"item_id"`
+	RevertTrigger types.String `tfsdk:"revert_trigger"`
+}
+
+func (r ComputeSnapshotResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Снимок дисков вычислительного экземпляра `vtb_compute_instance`.",
+		Attributes: map[string]schema.Attribute{
+			"vm_order_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Идентификатор заказа вычислительного экземпляра.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Имя снимка, уникальное в пределах ВМ.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"delete_date": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Дата автоматического удаления снимка в формате `YYYY-MM-DD`. " +
+					"Без значения снимок хранится до удаления ресурса.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+						"must be a date in format YYYY-MM-DD",
+					),
+				},
+			},
+			"volumes": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Идентификаторы дисков, вошедших в снимок. " +
+					"Без значения снимаются все диски ВМ.",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplaceIfConfigured(),
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"item_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Идентификатор сущности снимка в заказе ВМ.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"revert_trigger": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Произвольное значение, при изменении которого диски ВМ возвращаются " +
+					"к состоянию снимка. Все изменения на дисках после создания снимка будут потеряны.",
+			},
+		},
+	}
+}
+
+func (r ComputeSnapshotResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ComputeSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if revertRequested(&state, &plan) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("revert_trigger"),
+			"Virtual machine will be reverted",
+			fmt.Sprintf(
+				"Disks of virtual machine '%s' will be reverted to snapshot '%s', "+
+					"all changes made after the snapshot will be lost",
+				plan.VMOrderID.ValueString(), plan.Name.ValueString(),
+			),
+		)
+	}
+}
+
+func (r ComputeSnapshotResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ComputeSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetComputeOrder(r.client.Creds, r.client.ProjectName, plan.VMOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get compute order with order_id '%s'.\nError: %s",
+				plan.VMOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	snapshot, err := order.GetSnapshot(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, fmt.Sprintf("Can't get vm snapshots.\nError: %s", err.Error()))
+		return
+	}
+	if snapshot != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Snapshot '%s' already exists in compute order '%s'. Import it with `tofu import` instead",
+				plan.Name.ValueString(), plan.VMOrderID.ValueString(),
+			),
+		)
+		return
+	}
+
+	var volumes []string
+	if !plan.Volumes.IsUnknown() {
+		resp.Diagnostics.Append(plan.Volumes.ElementsAs(ctx, &volumes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err = order.CreateSnapshot(plan.Name.ValueString(), plan.DeleteDate.ValueString(), volumes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf("Snapshot '%s' wasn't created.\nError: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	snapshot, err = order.GetSnapshot(plan.Name.ValueString())
+	if err != nil || snapshot == nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf("Can't find created snapshot '%s' in compute order.\nError: %v", plan.Name.ValueString(), err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.readSnapshot(ctx, snapshot)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r ComputeSnapshotResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ComputeSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetComputeOrder(r.client.Creds, r.client.ProjectName, state.VMOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get compute order with order_id '%s'.\nError: %s",
+				state.VMOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	snapshot, err := order.GetSnapshot(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, fmt.Sprintf("Can't get vm snapshots.\nError: %s", err.Error()))
+		return
+	}
+	if snapshot == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(state.readSnapshot(ctx, snapshot)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r ComputeSnapshotResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ComputeSnapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if revertRequested(&state, &plan) {
+		order, err := orders.GetComputeOrder(r.client.Creds, r.client.ProjectName, plan.VMOrderID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				consts.UPDATE_RES_FAIL,
+				fmt.Sprintf(
+					"Can't get compute order with order_id '%s'.\nError: %s",
+					plan.VMOrderID.ValueString(), err.Error(),
+				),
+			)
+			return
+		}
+
+		err = order.RevertSnapshot(state.ItemID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("revert_trigger"),
+				consts.UPDATE_RES_FAIL,
+				fmt.Sprintf("Revert to snapshot '%s' ended with error.\nError: %s", plan.Name.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r ComputeSnapshotResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ComputeSnapshotResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetComputeOrder(r.client.Creds, r.client.ProjectName, state.VMOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get compute order with order_id '%s'.\nError: %s",
+				state.VMOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	snapshot, err := order.GetSnapshot(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(consts.DELETE_RES_FAIL, fmt.Sprintf("Can't get vm snapshots.\nError: %s", err.Error()))
+		return
+	}
+	if snapshot == nil {
+		return
+	}
+
+	err = order.DeleteSnapshot(snapshot.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf("Snapshot '%s' wasn't deleted.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ImportState принимает идентификатор в формате <vm_order_id>/<name>
+func (r ComputeSnapshotResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	orderID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || orderID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Import resource",
+			fmt.Sprintf("Unexpected compute snapshot id '%s'. Expected <vm_order_id>/<name>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vm_order_id"), orderID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// readSnapshot заполняет вычисляемые атрибуты из item снимка.
+// Портал может вернуть delete_date вместе со временем, в состоянии хранится только дата
+func (m *ComputeSnapshotResourceModel) readSnapshot(ctx context.Context, snapshot *entities.Item) (diags diag.Diagnostics) {
+	config, err := entities.ItemConfig[entities.SnapshotItemConfig](snapshot)
+	if err != nil {
+		diags.AddError(consts.READ_RES_FAIL, err.Error())
+		return diags
+	}
+
+	volumes, diags := types.ListValueFrom(ctx, types.StringType, config.Volumes)
+	m.Volumes = volumes
+	m.ItemID = types.StringValue(snapshot.ID)
+
+	deleteDate, _, _ := strings.Cut(config.DeleteDate, "T")
+	m.DeleteDate = types.StringNull()
+	if deleteDate != "" {
+		m.DeleteDate = types.StringValue(deleteDate)
+	}
+	return diags
+}
+
+// revertRequested ВМ возвращается к снимку, когда revert_trigger получает новое значение.
+// Удаление значения к возврату не приводит
+func revertRequested(state, plan *ComputeSnapshotResourceModel) bool {
+	return !plan.RevertTrigger.IsNull() && !plan.RevertTrigger.IsUnknown() &&
+		!plan.RevertTrigger.Equal(state.RevertTrigger)
+}
@@ -96,6 +96,8 @@ type BalancerV3ResourceModel struct {
 	SetupVersion types.String `tfsdk:"setup_version"`
 	ClusterName  types.String `tfsdk:"cluster_name"`
 	DNSZone      types.String `tfsdk:"dns_zone"`
+
+	PlannedActions types.List `tfsdk:"planned_actions"`
 }
 
 func (r BalancerV3Resource) Schema(
@@ -263,6 +265,7 @@ func (r BalancerV3Resource) Schema(
 				},
 				Attributes: BalancerV3ConfigScheme,
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -372,6 +375,7 @@ func (r BalancerV3Resource) Create(
 		}
 		plan.Config = tfConfig
 	}
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -381,7 +385,11 @@ func (r BalancerV3Resource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "*balancer_v3*"}},
+		req, resp,
+	)
 }
 
 func (r BalancerV3Resource) ModifyPlan(
@@ -400,6 +408,9 @@ func (r BalancerV3Resource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	validateRolesDiags := utils.ValidateAccessRolesV1(
 		r.client,
 		"cluster:balancer",
@@ -424,6 +435,12 @@ func (r BalancerV3Resource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r BalancerV3Resource) validateDnsZone(
@@ -566,8 +583,16 @@ func (r BalancerV3Resource) Read(
 	}
 
 	vmItem := balancerVmItems[0]
-	vmConfig := vmItem.Data.Config.(entities.VMItemConfig)
-	bI := balancerItem.Data.Config.(entities.BalancerV3ItemData)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	bI, err := entities.ItemConfig[entities.BalancerV3ItemData](balancerItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	prefix := ""
 	if strings.EqualFold(vmConfig.DefaultNic.NetSegment, "b2b-hce-ts-dev-srv-app") {
@@ -642,6 +667,7 @@ func (r BalancerV3Resource) Read(
 	tfConfig, diag := types.ObjectValueFrom(ctx, BalancerV3ConfigDataSourceModel{}.AttrTypes(), bI.Config)
 	resp.Diagnostics.Append(diag...)
 	state.Config = tfConfig
+	state.PlannedActions = utils.PriorPlannedActions(ctx, req.State)
 
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
@@ -663,6 +689,17 @@ func (r BalancerV3Resource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetBalancerV3Order(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -805,6 +842,45 @@ func (r BalancerV3Resource) Delete(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update.
+// Имена указаны без префикса версии balancer_v3_<minor>_
+func plannedActions(state, plan *BalancerV3ResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.Config.Equal(state.Config) {
+		actions.Add(orders.COMPLEX_APPLY, "Применение конфигурации балансировщика")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add(orders.EXPAND_MOUNT_POINT, "Расширение точки монтирования")
+	}
+
+	if !reflect.DeepEqual(plan.ActiveDirectoryAccess, state.ActiveDirectoryAccess) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive(orders.VERTICAL_SCALING, "Изменение конфигурации ВМ кластера с перезагрузкой")
+	}
+
+	if plan.SetupVersion != state.SetupVersion {
+		actions.AddDisruptive("balancer_v3_all_migrate_to_new_version", "Переход на новую версию с перезапуском балансировщика")
+	}
+
+	if plan.LayoutID != state.LayoutID {
+		actions.Add(orders.HORIZONTAL_SCALING, "Горизонтальное масштабирование кластера")
+	}
+	return actions
+}
+
 func (r BalancerV3Resource) prepareAtts(plan *BalancerV3ResourceModel) orders.BalancerV3Attrs {
 
 	ADLogonGrants := []entities.ADLogonGrants{}
@@ -105,6 +105,7 @@ type ClickHouseResourceModel struct {
 	ClickHouseAppAdminAdGroups map[string][]string `tfsdk:"clickhouse_app_admin_ad_groups"`
 	ClickHouseUserAdGroups     map[string][]string `tfsdk:"clickhouse_user_ad_groups"`
 	FinancialProject           types.String        `tfsdk:"financial_project"`
+	PlannedActions             types.List          `tfsdk:"planned_actions"`
 }
 
 func (r ClickHouseResource) Schema(
@@ -329,6 +330,7 @@ func (r ClickHouseResource) Schema(
 				Description:         "Источник финансирования.",
 				MarkdownDescription: "Источник финансирования.",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -338,7 +340,11 @@ func (r ClickHouseResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "clickhouse"}},
+		req, resp,
+	)
 }
 
 func (r ClickHouseResource) ModifyPlan(
@@ -353,6 +359,9 @@ func (r ClickHouseResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "app", "clickhouse")
 	resp.Diagnostics.Append(checkOrderIsDeleted.Diagnostics...)
 
@@ -377,6 +386,16 @@ func (r ClickHouseResource) ModifyPlan(
 		return
 	}
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state ClickHouseResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r ClickHouseResource) Create(
@@ -448,11 +467,15 @@ func (r ClickHouseResource) Create(
 		return
 	}
 
-	itemData := vmItems[0].Data
-
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
-	plan.Hostname = types.StringValue(itemData.Config.(entities.VMItemConfig).Hostname)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	plan.Hostname = types.StringValue(vmConfig.Hostname)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -628,6 +651,7 @@ func (r ClickHouseResource) Read(
 			},
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -651,6 +675,17 @@ func (r ClickHouseResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetClickHouseOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -796,6 +831,48 @@ func (r ClickHouseResource) Delete(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r ClickHouseResource) plannedActions(state, plan *ClickHouseResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !reflect.DeepEqual(state.Access, plan.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if state.ClickHousePassword != plan.ClickHousePassword {
+		actions.Add("clickhouse_reset_db_user_password", "Изменение пароля пользователя ClickHouse")
+	}
+
+	if state.ChCustomerPassword != plan.ChCustomerPassword {
+		actions.Add("clickhouse_reset_db_user_password", "Изменение пароля пользователя ch_customer")
+	}
+
+	toAdd, toDelete, _ := r.compareAppGroups(CLICKHOUSE_APP_ADMIN_ROLE_NAME, *plan, *state)
+	if len(toAdd) > 0 {
+		actions.Add("clickhouse_create_new_app_admin_group_ad", "Добавление групп администраторов приложения")
+	}
+	if len(toDelete) > 0 {
+		actions.Add("clickhouse_remove_new_app_admin_group_ad", "Удаление групп администраторов приложения")
+	}
+
+	toAdd, toDelete, _ = r.compareAppGroups(CLICKHOUSE_APP_USER_ROLE_NAME, *plan, *state)
+	if len(toAdd) > 0 {
+		actions.Add("clickhouse_create_new_app_user_group_ad", "Добавление групп пользователей приложения")
+	}
+	if len(toDelete) > 0 {
+		actions.Add("clickhouse_remove_new_app_user_group_ad", "Удаление групп пользователей приложения")
+	}
+	return actions
+}
+
 func (r ClickHouseResource) changeUserPassword(
 	order *orders.ClickHouse,
 	plan, state *ClickHouseResourceModel,
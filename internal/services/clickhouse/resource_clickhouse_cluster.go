@@ -105,6 +105,7 @@ type ClickHouseClusterResourceModel struct {
 	CHExtraMounts              map[string]common.ExtraMountModel `tfsdk:"ch_extra_mounts"`
 	ZKExtraMounts              map[string]common.ExtraMountModel `tfsdk:"zk_extra_mounts"`
 	Layout                     types.String                      `tfsdk:"layout"`
+	PlannedActions             types.List                        `tfsdk:"planned_actions"`
 }
 
 func (r ClickHouseClusterResource) Schema(
@@ -423,6 +424,7 @@ func (r ClickHouseClusterResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -432,7 +434,11 @@ func (r ClickHouseClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "clickhouse"}},
+		req, resp,
+	)
 }
 
 func (r ClickHouseClusterResource) ModifyPlan(
@@ -447,6 +453,9 @@ func (r ClickHouseClusterResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "cluster", "clickhouse")
 	resp.Diagnostics.Append(checkOrderIsDeleted.Diagnostics...)
 
@@ -491,6 +500,17 @@ func (r ClickHouseClusterResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state ClickHouseClusterResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r ClickHouseClusterResource) Create(
@@ -580,6 +600,7 @@ func (r ClickHouseClusterResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -815,6 +836,7 @@ func (r ClickHouseClusterResource) Read(
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
 		Layout:           types.StringValue(order.Attrs.Layout),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -838,6 +860,17 @@ func (r ClickHouseClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetClickhouseClusterOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -986,6 +1019,59 @@ func (r ClickHouseClusterResource) Delete(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r ClickHouseClusterResource) plannedActions(state, plan *ClickHouseClusterResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !reflect.DeepEqual(state.Access, plan.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if state.ClickHousePassword != plan.ClickHousePassword {
+		actions.Add("clickhouse_cluster_reset_db_user_password", "Изменение пароля администратора ClickHouse")
+	}
+
+	if state.ChCustomerPassword != plan.ChCustomerPassword {
+		actions.Add("clickhouse_cluster_reset_db_user_password", "Изменение пароля пользователя ch_customer")
+	}
+
+	toAdd, toDelete, _ := r.compareAppGroups(CLICKHOUSE_APP_ADMIN_ROLE_NAME, *plan, *state)
+	if len(toAdd) > 0 {
+		actions.Add("clickhouse_cluster_create_new_app_admin_group_ad", "Добавление групп администраторов приложения")
+	}
+	if len(toDelete) > 0 {
+		actions.Add("clickhouse_cluster_remove_new_app_admin_group_ad", "Удаление групп администраторов приложения")
+	}
+
+	toAdd, toDelete, _ = r.compareAppGroups(CLICKHOUSE_APP_USER_ROLE_NAME, *plan, *state)
+	if len(toAdd) > 0 {
+		actions.Add("clickhouse_cluster_create_new_app_user_group_ad", "Добавление групп пользователей приложения")
+	}
+	if len(toDelete) > 0 {
+		actions.Add("clickhouse_cluster_remove_new_app_user_group_ad", "Удаление групп пользователей приложения")
+	}
+
+	if plan.FlavorCH != state.FlavorCH {
+		actions.AddDisruptive("vertical_resize_clickhouse_cluster", "Изменение конфигурации ВМ ClickHouse с перезагрузкой")
+	}
+
+	if plan.FlavorZK != state.FlavorZK {
+		actions.AddDisruptive(
+			"vertical_resize_clickhouse_cluster_zookeeper",
+			"Изменение конфигурации ВМ ZooKeeper с перезагрузкой",
+		)
+	}
+	return actions
+}
+
 func (r ClickHouseClusterResource) changeFlavorClickHouseCluster(
 	order *orders.ClickHouseCluster,
 	plan *ClickHouseClusterResourceModel,
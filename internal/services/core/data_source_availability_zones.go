@@ -0,0 +1,200 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &AvailabilityZonesDataSource{}
+)
+
+type AvailabilityZonesDataSource struct {
+	client *client.CloudClient
+}
+
+func NewAvailabilityZonesDataSource() datasource.DataSource {
+	return &AvailabilityZonesDataSource{}
+}
+
+func (d AvailabilityZonesDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_availability_zones"
+}
+
+func (d *AvailabilityZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type AvailabilityZonesModel struct {
+	NetSegment         types.String            `tfsdk:"net_segment"`
+	EnvironmentType    types.String            `tfsdk:"environment_type"`
+	CurrentEnvironment types.Bool              `tfsdk:"current_environment"`
+	DataCenterID       types.String            `tfsdk:"data_center_id"`
+	CodeRegex          types.String            `tfsdk:"code_regex"`
+	IncludeDeleted     types.Bool              `tfsdk:"include_deleted"`
+	Codes              []string                `tfsdk:"codes"`
+	Zones              []AvailabilityZoneModel `tfsdk:"zones"`
+}
+
+type AvailabilityZoneModel struct {
+	ID               types.String `tfsdk:"id"`
+	Code             types.String `tfsdk:"code"`
+	Label            types.String `tfsdk:"label"`
+	DataCenterIDs    []string     `tfsdk:"data_center_ids"`
+	EnvironmentTypes []string     `tfsdk:"environment_types"`
+	IsDeleted        types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d AvailabilityZonesDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Зоны доступности сетевого сегмента в организации провайдера",
+		Attributes: map[string]schema.Attribute{
+			"net_segment": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Код сетевого сегмента. Пример: dev-srv-app",
+			},
+			"environment_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Оставить только зоны, доступные для типа среды. Пример: DEV",
+			},
+			"current_environment": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Оставить только зоны, доступные для типа среды проекта провайдера. " +
+					"Так же выбирает зоны `vtb_core_data`",
+			},
+			"data_center_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Оставить только зоны, включающие центр обработки данных",
+			},
+			"code_regex":      codeRegexAttribute(),
+			"include_deleted": includeDeletedAttribute(),
+			"codes":           codesAttribute(),
+			"zones": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Зоны доступности",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор зоны доступности",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код зоны доступности. Пример: msk-north",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Название зоны доступности",
+						},
+						"data_center_ids": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Идентификаторы центров обработки данных зоны",
+						},
+						"environment_types": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Типы сред, для которых доступна зона",
+						},
+						"is_deleted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Зона доступности удалена",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AvailabilityZonesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data AvailabilityZonesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newCodeFilter(data.CodeRegex, data.IncludeDeleted, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := sources.GetAvailAbilityZones(d.client.Creds, data.NetSegment.ValueString(), d.client.Organization)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get availability zones for network segment '%s'.\nError: %s",
+				data.NetSegment.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	data.Codes = []string{}
+	data.Zones = []AvailabilityZoneModel{}
+	for _, zone := range zones {
+		if !filter.match(zone.Code, zone.IsDeleted) {
+			continue
+		}
+		environmentType := data.EnvironmentType.ValueString()
+		if environmentType != "" && !slices.Contains(zone.EnvironmentTypes, environmentType) {
+			continue
+		}
+		if data.CurrentEnvironment.ValueBool() && !slices.Contains(zone.EnvironmentTypes, d.client.Environment) {
+			continue
+		}
+		dataCenterID := data.DataCenterID.ValueString()
+		if dataCenterID != "" && !slices.Contains(zone.DataCenterIds, dataCenterID) {
+			continue
+		}
+
+		data.Codes = append(data.Codes, zone.Code)
+		data.Zones = append(data.Zones, AvailabilityZoneModel{
+			ID:               types.StringValue(zone.ID),
+			Code:             types.StringValue(zone.Code),
+			Label:            types.StringValue(zone.Label),
+			DataCenterIDs:    append([]string{}, zone.DataCenterIds...),
+			EnvironmentTypes: append([]string{}, zone.EnvironmentTypes...),
+			IsDeleted:        types.BoolValue(zone.IsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,206 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &DataCentersDataSource{}
+)
+
+type DataCentersDataSource struct {
+	client *client.CloudClient
+}
+
+func NewDataCentersDataSource() datasource.DataSource {
+	return &DataCentersDataSource{}
+}
+
+func (d DataCentersDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_data_centers"
+}
+
+func (d *DataCentersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type DataCentersModel struct {
+	NetSegment     types.String      `tfsdk:"net_segment"`
+	Environment    types.String      `tfsdk:"environment"`
+	Site           types.String      `tfsdk:"site"`
+	CodeRegex      types.String      `tfsdk:"code_regex"`
+	IncludeDeleted types.Bool        `tfsdk:"include_deleted"`
+	Codes          []string          `tfsdk:"codes"`
+	DataCenters    []DataCenterModel `tfsdk:"data_centers"`
+}
+
+type DataCenterModel struct {
+	ID        types.String `tfsdk:"id"`
+	Code      types.String `tfsdk:"code"`
+	Name      types.String `tfsdk:"name"`
+	Label     types.String `tfsdk:"label"`
+	Site      types.String `tfsdk:"site"`
+	Status    types.String `tfsdk:"status"`
+	Weight    types.Int64  `tfsdk:"weight"`
+	IsDeleted types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d DataCentersDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Центры обработки данных, доступные для заказа в проекте провайдера",
+		Attributes: map[string]schema.Attribute{
+			"net_segment": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Код сетевого сегмента. Пример: dev-srv-app",
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Тип среды. По умолчанию тип среды проекта провайдера",
+			},
+			"site": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Оставить только центры обработки данных площадки",
+			},
+			"code_regex":      codeRegexAttribute(),
+			"include_deleted": includeDeletedAttribute(),
+			"codes":           codesAttribute(),
+			"data_centers": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Центры обработки данных",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор центра обработки данных",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код центра обработки данных",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Имя центра обработки данных",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Название центра обработки данных",
+						},
+						"site": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Площадка",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Статус центра обработки данных",
+						},
+						"weight": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Вес центра обработки данных для сортировки на портале",
+						},
+						"is_deleted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Центр обработки данных удален",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d DataCentersDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DataCentersModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newCodeFilter(data.CodeRegex, data.IncludeDeleted, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Environment.ValueString() == "" {
+		data.Environment = types.StringValue(d.client.Environment)
+	}
+
+	dataCenters, err := sources.GetDataCenters(
+		d.client.Creds,
+		d.client.ProjectName,
+		d.client.Organization,
+		data.Environment.ValueString(),
+		data.NetSegment.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get data centers for network segment '%s'.\nError: %s",
+				data.NetSegment.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	data.Codes = []string{}
+	data.DataCenters = []DataCenterModel{}
+	for _, dataCenter := range dataCenters {
+		if !filter.match(dataCenter.Code, dataCenter.IsDeleted) {
+			continue
+		}
+		if site := data.Site.ValueString(); site != "" && dataCenter.Site != site {
+			continue
+		}
+
+		data.Codes = append(data.Codes, dataCenter.Code)
+		data.DataCenters = append(data.DataCenters, DataCenterModel{
+			ID:        types.StringValue(dataCenter.Id),
+			Code:      types.StringValue(dataCenter.Code),
+			Name:      types.StringValue(dataCenter.Name),
+			Label:     types.StringValue(dataCenter.Label),
+			Site:      types.StringValue(dataCenter.Site),
+			Status:    types.StringValue(dataCenter.Status),
+			Weight:    types.Int64Value(int64(dataCenter.Weight)),
+			IsDeleted: types.BoolValue(dataCenter.IsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,168 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &DomainsDataSource{}
+)
+
+type DomainsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+func (d DomainsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *DomainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type DomainsModel struct {
+	NetSegment     types.String  `tfsdk:"net_segment"`
+	CodeRegex      types.String  `tfsdk:"code_regex"`
+	IncludeDeleted types.Bool    `tfsdk:"include_deleted"`
+	Codes          []string      `tfsdk:"codes"`
+	Domains        []DomainModel `tfsdk:"domains"`
+}
+
+type DomainModel struct {
+	ID        types.String `tfsdk:"id"`
+	Code      types.String `tfsdk:"code"`
+	Label     types.String `tfsdk:"label"`
+	Weight    types.Int64  `tfsdk:"weight"`
+	IsDeleted types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d DomainsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Доменные зоны, доступные для заказа",
+		Attributes: map[string]schema.Attribute{
+			"net_segment": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Код сетевого сегмента. Пример: dev-srv-app. " +
+					"Если не задан, возвращаются домены проекта провайдера",
+			},
+			"code_regex":      codeRegexAttribute(),
+			"include_deleted": includeDeletedAttribute(),
+			"codes":           codesAttribute(),
+			"domains": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Доменные зоны",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор домена",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код домена. Пример: corp.dev.vtb",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Название домена",
+						},
+						"weight": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Вес домена для сортировки на портале",
+						},
+						"is_deleted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Домен удален",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d DomainsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DomainsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newCodeFilter(data.CodeRegex, data.IncludeDeleted, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domains []entities.Domain
+	var err error
+	if data.NetSegment.ValueString() != "" {
+		domains, err = sources.GetDomains(d.client.Creds, data.NetSegment.ValueString(), d.client.Organization)
+	} else {
+		domains, err = sources.GetDomainsByProjectName(d.client.Creds, d.client.ProjectName)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't get domains.\nError: %s", err.Error()),
+		)
+		return
+	}
+
+	data.Codes = []string{}
+	data.Domains = []DomainModel{}
+	for _, domain := range domains {
+		if !filter.match(domain.Code, domain.IsDeleted) {
+			continue
+		}
+		data.Codes = append(data.Codes, domain.Code)
+		data.Domains = append(data.Domains, DomainModel{
+			ID:        types.StringValue(domain.ID),
+			Code:      types.StringValue(domain.Code),
+			Label:     types.StringValue(domain.Label),
+			Weight:    types.Int64Value(int64(domain.Weight)),
+			IsDeleted: types.BoolValue(domain.IsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
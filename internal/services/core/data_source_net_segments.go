@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &NetSegmentsDataSource{}
+)
+
+type NetSegmentsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewNetSegmentsDataSource() datasource.DataSource {
+	return &NetSegmentsDataSource{}
+}
+
+func (d NetSegmentsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_net_segments"
+}
+
+func (d *NetSegmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type NetSegmentsModel struct {
+	CodeRegex      types.String      `tfsdk:"code_regex"`
+	IncludeDeleted types.Bool        `tfsdk:"include_deleted"`
+	Codes          []string          `tfsdk:"codes"`
+	NetSegments    []NetSegmentModel `tfsdk:"net_segments"`
+}
+
+type NetSegmentModel struct {
+	ID        types.String `tfsdk:"id"`
+	Code      types.String `tfsdk:"code"`
+	Label     types.String `tfsdk:"label"`
+	Weight    types.Int64  `tfsdk:"weight"`
+	IsDeleted types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d NetSegmentsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Сетевые сегменты, доступные проекту провайдера",
+		Attributes: map[string]schema.Attribute{
+			"code_regex":      codeRegexAttribute(),
+			"include_deleted": includeDeletedAttribute(),
+			"codes":           codesAttribute(),
+			"net_segments": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Сетевые сегменты",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор сетевого сегмента",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код сетевого сегмента. Пример: dev-srv-app",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Название сетевого сегмента",
+						},
+						"weight": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Вес сетевого сегмента для сортировки на портале",
+						},
+						"is_deleted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Сетевой сегмент удален",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d NetSegmentsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data NetSegmentsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newCodeFilter(data.CodeRegex, data.IncludeDeleted, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	netSegments, err := sources.GetNetSegments(d.client.Creds, d.client.ProjectName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't get network segments for project '%s'.\nError: %s", d.client.ProjectName, err.Error()),
+		)
+		return
+	}
+
+	data.Codes = []string{}
+	data.NetSegments = []NetSegmentModel{}
+	for _, segment := range netSegments {
+		if !filter.match(segment.Code, segment.IsDeleted) {
+			continue
+		}
+		data.Codes = append(data.Codes, segment.Code)
+		data.NetSegments = append(data.NetSegments, NetSegmentModel{
+			ID:        types.StringValue(segment.Id),
+			Code:      types.StringValue(segment.Code),
+			Label:     types.StringValue(segment.Label),
+			Weight:    types.Int64Value(int64(segment.Weight)),
+			IsDeleted: types.BoolValue(segment.IsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,194 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &PlatformsDataSource{}
+)
+
+type PlatformsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewPlatformsDataSource() datasource.DataSource {
+	return &PlatformsDataSource{}
+}
+
+func (d PlatformsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_platforms"
+}
+
+func (d *PlatformsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type PlatformsModel struct {
+	NetSegment        types.String    `tfsdk:"net_segment"`
+	Zone              types.String    `tfsdk:"zone"`
+	ExcludeRestricted types.Bool      `tfsdk:"exclude_restricted"`
+	CodeRegex         types.String    `tfsdk:"code_regex"`
+	IncludeDeleted    types.Bool      `tfsdk:"include_deleted"`
+	Codes             []string        `tfsdk:"codes"`
+	Platforms         []PlatformModel `tfsdk:"platforms"`
+}
+
+type PlatformModel struct {
+	ID                types.String `tfsdk:"id"`
+	Code              types.String `tfsdk:"code"`
+	Label             types.String `tfsdk:"label"`
+	Status            types.String `tfsdk:"status"`
+	RestrictionReason types.String `tfsdk:"restriction_reason"`
+	Weight            types.Int64  `tfsdk:"weight"`
+	IsDeleted         types.Bool   `tfsdk:"is_deleted"`
+}
+
+func (d PlatformsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Платформы, доступные для заказа в сетевом сегменте и зоне доступности",
+		Attributes: map[string]schema.Attribute{
+			"net_segment": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Код сетевого сегмента. Пример: dev-srv-app",
+			},
+			"zone": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Код зоны доступности. Пример: msk-north",
+			},
+			"exclude_restricted": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Исключить платформы с ограничением на заказ",
+			},
+			"code_regex":      codeRegexAttribute(),
+			"include_deleted": includeDeletedAttribute(),
+			"codes":           codesAttribute(),
+			"platforms": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Платформы",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор платформы",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код платформы. Пример: OpenStack",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Название платформы",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Статус платформы",
+						},
+						"restriction_reason": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Причина ограничения заказа на платформе",
+						},
+						"weight": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "Вес платформы для сортировки на портале",
+						},
+						"is_deleted": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Платформа удалена",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d PlatformsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data PlatformsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := newCodeFilter(data.CodeRegex, data.IncludeDeleted, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	platforms, err := sources.GetPlatforms(
+		d.client.Creds,
+		data.NetSegment.ValueString(),
+		d.client.Organization,
+		data.Zone.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get platforms for network segment '%s' and zone '%s'.\nError: %s",
+				data.NetSegment.ValueString(), data.Zone.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	data.Codes = []string{}
+	data.Platforms = []PlatformModel{}
+	for _, platform := range platforms {
+		if !filter.match(platform.Code, platform.IsDeleted) {
+			continue
+		}
+		if data.ExcludeRestricted.ValueBool() && platform.RestrictionReason != "" {
+			continue
+		}
+
+		data.Codes = append(data.Codes, platform.Code)
+		data.Platforms = append(data.Platforms, PlatformModel{
+			ID:                types.StringValue(platform.ID),
+			Code:              types.StringValue(platform.Code),
+			Label:             types.StringValue(platform.Label),
+			Status:            types.StringValue(platform.Status),
+			RestrictionReason: types.StringValue(platform.RestrictionReason),
+			Weight:            types.Int64Value(int64(platform.Weight)),
+			IsDeleted:         types.BoolValue(platform.IsDeleted),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Общие фильтры для списочных источников данных топологии портала
+
+func codeRegexAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: "Регулярное выражение для фильтрации по коду. Пример: `^dev-`",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+func includeDeletedAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Включать удаленные записи. По умолчанию `false`",
+	}
+}
+
+func codesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Коды найденных записей",
+	}
+}
+
+type codeFilter struct {
+	regex          *regexp.Regexp
+	includeDeleted bool
+}
+
+func newCodeFilter(codeRegex types.String, includeDeleted types.Bool, diags *diag.Diagnostics) codeFilter {
+	filter := codeFilter{includeDeleted: includeDeleted.ValueBool()}
+	if codeRegex.IsNull() || codeRegex.IsUnknown() {
+		return filter
+	}
+
+	regex, err := regexp.Compile(codeRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("code_regex"), "Invalid regular expression", err.Error())
+		return filter
+	}
+	filter.regex = regex
+	return filter
+}
+
+func (f codeFilter) match(code string, isDeleted bool) bool {
+	if isDeleted && !f.includeDeleted {
+		return false
+	}
+	return f.regex == nil || f.regex.MatchString(code)
+}
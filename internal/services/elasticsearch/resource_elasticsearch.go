@@ -115,6 +115,8 @@ type ElasticSearchResourceModel struct {
 	DataExtraMounts        map[string]common.ExtraMountModel `tfsdk:"data_extra_mounts"`
 	MasterExtraMounts      map[string]common.ExtraMountModel `tfsdk:"master_extra_mounts"`
 	CoordinatorExtraMounts map[string]common.ExtraMountModel `tfsdk:"coordinator_extra_mounts"`
+
+	PlannedActions types.List `tfsdk:"planned_actions"`
 }
 
 type NodesCount struct {
@@ -471,6 +473,7 @@ func (r ElasticSearchResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -480,7 +483,11 @@ func (r ElasticSearchResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "elasticsearch_os"}},
+		req, resp,
+	)
 }
 
 func (r ElasticSearchResource) ModifyPlan(
@@ -495,6 +502,9 @@ func (r ElasticSearchResource) ModifyPlan(
 	var plan, state ElasticSearchResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -516,6 +526,12 @@ func (r ElasticSearchResource) ModifyPlan(
 	}
 
 	resp.Diagnostics.Append(req.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r ElasticSearchResource) Create(
@@ -587,6 +603,7 @@ func (r ElasticSearchResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(elasticItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -756,6 +773,7 @@ func (r ElasticSearchResource) Read(
 		Access:           utils.ReadAccessMapV2(vmAcls),
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
 		Layout:           types.StringValue(order.Attrs.Layout),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 		Core: core.CoreModel{
 			Platform:       types.StringValue(order.Attrs.Platform),
 			Domain:         types.StringValue(order.Attrs.Domain),
@@ -860,6 +878,17 @@ func (r ElasticSearchResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetElasticSearchOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -1102,6 +1131,55 @@ func (r ElasticSearchResource) prepareElasticAttrs(
 	return attrs
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r ElasticSearchResource) plannedActions(state, plan *ElasticSearchResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if plan.FinancialProject != state.FinancialProject {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.KibanaPassword.Equal(state.KibanaPassword) {
+		actions.Add("elasticsearch_opensearch_reset_kibana_password", "Изменение пароля Kibana")
+	}
+
+	if !plan.FluentdPassword.Equal(state.FluentdPassword) {
+		actions.Add("elasticsearch_opensearch_reset_fluentd_password", "Изменение пароля Fluentd")
+	}
+
+	if plan.FlavorMaster != state.FlavorMaster {
+		actions.AddDisruptive("vertical_resize_opensearch_master_nodes", "Изменение конфигурации master узлов с перезагрузкой")
+	}
+
+	if plan.FlavorData != state.FlavorData {
+		actions.AddDisruptive("vertical_resize_opensearch_data_nodes", "Изменение конфигурации data узлов с перезагрузкой")
+	}
+
+	if plan.FlavorCoordintor != state.FlavorCoordintor {
+		actions.AddDisruptive(
+			"vertical_resize_opensearch_coordinator_nodes",
+			"Изменение конфигурации coordinator узлов с перезагрузкой",
+		)
+	}
+
+	if plan.ElasticSearchNodesCount != state.ElasticSearchNodesCount {
+		if strings.EqualFold(r.client.Environment, "prod") {
+			actions.Add("enlarge_elastic_cluster_geodistribution", "Горизонтальное масштабирование кластера")
+		} else {
+			actions.Add("enlarge_elastic_cluster", "Горизонтальное масштабирование кластера")
+		}
+	}
+
+	if utils.IsExtraMountChanged(state.DataExtraMounts, plan.DataExtraMounts) {
+		actions.Add("expand_mount_data_opensearch", "Расширение точки монтирования data узлов")
+	}
+	return actions
+}
+
 func (r ElasticSearchResource) changeFinancialProject(
 	order *orders.ElasticSearch,
 	finProjectId string,
@@ -94,6 +94,7 @@ type EtcdResourceModel struct {
 	EtcdVersion      types.String            `tfsdk:"etcd_version"`
 	NodesCount       types.Int64             `tfsdk:"nodes_count"`
 	Layout           types.String            `tfsdk:"layout"`
+	PlannedActions   types.List              `tfsdk:"planned_actions"`
 }
 
 func (r EtcdResource) Schema(
@@ -335,6 +336,7 @@ func (r EtcdResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -344,7 +346,11 @@ func (r EtcdResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "etcd"}},
+		req, resp,
+	)
 }
 
 func (r EtcdResource) ModifyPlan(
@@ -359,6 +365,9 @@ func (r EtcdResource) ModifyPlan(
 	var plan, state EtcdResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -407,6 +416,12 @@ func (r EtcdResource) ModifyPlan(
 	}
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r EtcdResource) Create(
@@ -492,6 +507,7 @@ func (r EtcdResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -573,7 +589,11 @@ func (r EtcdResource) Read(
 	data.NodesCount = types.Int64Value(int64(len(vmItems)))
 
 	vmItem := vmItems[0]
-	vmConfig := vmItem.Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	data.Label = types.StringValue(order.Label)
 	data.ItemID = types.StringValue(item.ID)
@@ -583,6 +603,7 @@ func (r EtcdResource) Read(
 	data.EtcdUserPassword = etcdUserPassword
 	data.Access = utils.ReadAccessMapVV1(vmItem.Data.ACLs)
 	data.FinancialProject = types.StringValue(order.FinancialSource.Name)
+	data.PlannedActions = utils.PriorPlannedActions(ctx, req.State)
 	data.EtcdVersion = types.StringValue(strings.Split(config.Version, "v")[1])
 	data.Flavor = flavor.FlavorModel{
 		Cores:  types.Int64Value(vmConfig.Flavor.Cores),
@@ -650,6 +671,17 @@ func (r EtcdResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetEtcdOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -804,13 +836,47 @@ func (r EtcdResource) Delete(
 
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *EtcdResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.Label.Equal(state.Label) {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if state.EtcdUserPassword != plan.EtcdUserPassword {
+		actions.Add("etcd_reset_user_pass_without_ssl", "Изменение пароля пользователя Etcd")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if state.Flavor != plan.Flavor {
+		actions.AddDisruptive("resize_vm", "Изменение конфигурации ВМ с перезагрузкой")
+	}
+
+	if !reflect.DeepEqual(state.Access, plan.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+	return actions
+}
+
 func changeEtcdExtraMounts(
 	order *orders.EtcdOrder,
 	planResource *EtcdResourceModel,
 ) (diags diag.Diagnostics) {
 	vmItems, _ := order.GetVMItems()
 	for _, vmItem := range vmItems {
-		config := vmItem.Data.Config.(entities.VMItemConfig)
+		config, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			diags.AddError(consts.UPDATE_RES_FAIL, err.Error())
+			return diags
+		}
 		for _, vmExtraMount := range config.ExtraMounts {
 			planExtraMountSize := planResource.ExtraMounts[vmExtraMount.Mount+"/"].Size.ValueInt64()
 			if planExtraMountSize != int64(vmExtraMount.Size) && planExtraMountSize > int64(vmExtraMount.Size) {
@@ -838,7 +904,11 @@ func changeEtcdFlavor(
 	}
 	vmItems, _ := order.GetVMItems()
 	for _, vmItem := range vmItems {
-		config := vmItem.Data.Config.(entities.VMItemConfig)
+		config, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			diags.AddError(consts.UPDATE_RES_FAIL, err.Error())
+			return diags
+		}
 		if config.Flavor != flavor {
 			err := order.ChangeFlavorForVm(vmItem.ID, flavor)
 			if err != nil {
@@ -0,0 +1,135 @@
+package financialproject
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &FinancialProjectDataSource{}
+)
+
+type FinancialProjectDataSource struct {
+	client *client.CloudClient
+}
+
+func NewFinancialProjectDataSource() datasource.DataSource {
+	return &FinancialProjectDataSource{}
+}
+
+func (d FinancialProjectDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_financial_project"
+}
+
+func (d *FinancialProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type FinancialProjectModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Code types.String `tfsdk:"code"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (d FinancialProjectDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Активный источник финансирования проекта провайдера. Задается `name` или `id`",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Идентификатор источника финансирования",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Название источника финансирования, которое указывается в `financial_project` ресурсов",
+			},
+			"code": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Код источника финансирования",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Тип источника финансирования",
+			},
+		},
+	}
+}
+
+func (d FinancialProjectDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data FinancialProjectModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var finProject *entities.FinancialProject
+	var err error
+	if data.ID.ValueString() != "" {
+		finProject, err = sources.GetFinancialProjectByID(d.client.Creds, d.client.ProjectName, data.ID.ValueString())
+	} else {
+		finProject, err = sources.GetFinancialProjectByName(d.client.Creds, d.client.ProjectName, data.Name.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't get financial project.\nError: %s", err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, financialProjectModel(finProject))...)
+}
+
+func financialProjectModel(finProject *entities.FinancialProject) FinancialProjectModel {
+	return FinancialProjectModel{
+		ID:   types.StringValue(finProject.ID),
+		Name: types.StringValue(finProject.Name),
+		Code: types.StringValue(finProject.Code),
+		Type: types.StringValue(finProject.Type),
+	}
+}
@@ -0,0 +1,137 @@
+package financialproject
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &FinancialProjectsDataSource{}
+)
+
+type FinancialProjectsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewFinancialProjectsDataSource() datasource.DataSource {
+	return &FinancialProjectsDataSource{}
+}
+
+func (d FinancialProjectsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_financial_projects"
+}
+
+func (d *FinancialProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type FinancialProjectsModel struct {
+	Query             types.String            `tfsdk:"query"`
+	Names             []string                `tfsdk:"names"`
+	FinancialProjects []FinancialProjectModel `tfsdk:"financial_projects"`
+}
+
+func (d FinancialProjectsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Активные источники финансирования проекта провайдера",
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Строка поиска по названию источника финансирования",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Названия найденных источников финансирования",
+			},
+			"financial_projects": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Источники финансирования",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор источника финансирования",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Название источника финансирования",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Код источника финансирования",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Тип источника финансирования",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d FinancialProjectsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data FinancialProjectsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	finProjects, err := sources.GetFinancialProjects(d.client.Creds, d.client.ProjectName, data.Query.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get financial projects for project '%s'.\nError: %s",
+				d.client.ProjectName, err.Error(),
+			),
+		)
+		return
+	}
+
+	data.Names = []string{}
+	data.FinancialProjects = []FinancialProjectModel{}
+	for i := range finProjects {
+		data.Names = append(data.Names, finProjects[i].Name)
+		data.FinancialProjects = append(data.FinancialProjects, financialProjectModel(&finProjects[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -89,6 +89,7 @@ type GrafanaResourceModel struct {
 	GrafanaUserName     types.String              `tfsdk:"grafana_user_name"`
 	GrafanaUserPassword types.String              `tfsdk:"grafana_user_password"`
 	GrafanaVersion      types.String              `tfsdk:"grafana_version"`
+	PlannedActions      types.List                `tfsdk:"planned_actions"`
 }
 
 func (r GrafanaResource) Schema(
@@ -282,6 +283,7 @@ func (r GrafanaResource) Schema(
 						"Password must match pattern ^[a-zA-Z0-9\\._-]{20,64}$"),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -290,7 +292,11 @@ func (r GrafanaResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "grafana"}},
+		req, resp,
+	)
 }
 
 func (r GrafanaResource) ModifyPlan(
@@ -305,6 +311,9 @@ func (r GrafanaResource) ModifyPlan(
 	var plan GrafanaResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "app", "grafana")
 	resp.Diagnostics.Append(checkOrderIsDeleted.Diagnostics...)
 
@@ -320,6 +329,16 @@ func (r GrafanaResource) ModifyPlan(
 		return
 	}
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state GrafanaResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r GrafanaResource) Create(
@@ -413,6 +432,7 @@ func (r GrafanaResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -466,7 +486,11 @@ func (r GrafanaResource) Read(
 		return
 	}
 
-	config := item.Data.Config.(entities.GrafanaItemConfig)
+	config, err := entities.ItemConfig[entities.GrafanaItemConfig](item)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 	grafanaUser, err := order.GetDefaultUser()
 
 	if err != nil {
@@ -487,7 +511,11 @@ func (r GrafanaResource) Read(
 	}
 
 	vmItem = vmItems[0]
-	vmConfig := vmItem.Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	data.Label = types.StringValue(order.Label)
 	data.ItemID = types.StringValue(item.ID)
@@ -495,6 +523,7 @@ func (r GrafanaResource) Read(
 	data.GrafanaUserPassword = grafanaUserPassword
 	data.Access = utils.ReadAccessMapVV1(vmItem.Data.ACLs)
 	data.FinancialProject = types.StringValue(order.FinancialSource.Name)
+	data.PlannedActions = utils.PriorPlannedActions(ctx, req.State)
 	data.GrafanaVersion = types.StringValue(config.GrafanaVersion)
 	data.Flavor = flavor.FlavorModel{
 		Cores:  types.Int64Value(vmConfig.Flavor.Cores),
@@ -556,6 +585,20 @@ func (r GrafanaResource) Update(
 	resp.Diagnostics.Append(diags...)
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetGrafanaOrder(
 		r.client.Creds,
@@ -685,13 +728,47 @@ func (r GrafanaResource) Delete(
 
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *GrafanaResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !reflect.DeepEqual(plan.Access, state.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if state.Flavor != plan.Flavor {
+		actions.AddDisruptive("resize_vm", "Изменение конфигурации ВМ с перезагрузкой")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if state.GrafanaUserPassword != plan.GrafanaUserPassword {
+		actions.Add("reset_grafana_user_password", "Изменение пароля пользователя Grafana")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+	return actions
+}
+
 func changeGrafanaExtraMounts(
 	order *orders.GrafanaOrder,
 	planResource *GrafanaResourceModel,
 ) (diags diag.Diagnostics) {
 	vmItems, _ := order.GetVMItems()
 	for _, vmItem := range vmItems {
-		config := vmItem.Data.Config.(entities.VMItemConfig)
+		config, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			diags.AddError(consts.UPDATE_RES_FAIL, err.Error())
+			return diags
+		}
 		for _, vmExtraMount := range config.ExtraMounts {
 			planExtraMountSize := planResource.ExtraMounts[vmExtraMount.Mount].Size.ValueInt64()
 			if planExtraMountSize != int64(vmExtraMount.Size) && planExtraMountSize > int64(vmExtraMount.Size) {
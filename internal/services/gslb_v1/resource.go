@@ -93,6 +93,9 @@ func (r *GSLBV1Resource) ModifyPlan(
 	var plan, state GSLBV1ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(
 		r.client,
 		plan.OrderID,
@@ -125,7 +128,11 @@ func (r *GSLBV1Resource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "*gslb_cluster_v1*"}},
+		req, resp,
+	)
 }
 
 func (r *GSLBV1Resource) Create(
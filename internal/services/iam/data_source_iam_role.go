@@ -0,0 +1,152 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/iam"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &IAMRoleDataSource{}
+)
+
+type IAMRoleDataSource struct {
+	client *client.CloudClient
+}
+
+func NewIAMRoleDataSource() datasource.DataSource {
+	return &IAMRoleDataSource{}
+}
+
+func (d IAMRoleDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_iam_role"
+}
+
+func (d *IAMRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type IAMRoleDataSourceModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	Type         types.String `tfsdk:"type"`
+	Permissions  []string     `tfsdk:"permissions"`
+}
+
+func (d IAMRoleDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Роль организации в IAM.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Организация роли. По умолчанию организация проекта провайдера.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Имя роли или полное имя organizations/<organization>/roles/<name>.",
+			},
+			"full_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Полное имя роли.",
+			},
+			"title": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Отображаемое название роли.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Описание роли.",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Тип роли.",
+			},
+			"permissions": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Разрешения роли.",
+			},
+		},
+	}
+}
+
+func (d IAMRoleDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data IAMRoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization := data.Organization.ValueString()
+	parts := strings.Split(data.Name.ValueString(), "/")
+	if len(parts) == 4 && parts[0] == "organizations" {
+		organization = parts[1]
+	}
+	if organization == "" {
+		organization = d.client.Organization
+	}
+
+	role, err := iam.GetOrganizationRoleByName(d.client.Creds, organization, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't find role '%s' in organization '%s'.\nError: %s",
+				data.Name.ValueString(), organization, err.Error(),
+			),
+		)
+		return
+	}
+
+	permissions := append([]string{}, role.Permissions...)
+	sort.Strings(permissions)
+
+	data.Organization = types.StringValue(organization)
+	data.FullName = types.StringValue(iam.RoleFullName(organization, data.Name.ValueString()))
+	data.Title = types.StringValue(role.Title)
+	data.Description = types.StringValue(role.Description)
+	data.Type = types.StringValue(role.Type)
+	data.Permissions = permissions
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
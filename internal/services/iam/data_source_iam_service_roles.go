@@ -0,0 +1,147 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/iam"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &IAMServiceRolesDataSource{}
+)
+
+type IAMServiceRolesDataSource struct {
+	client *client.CloudClient
+}
+
+func NewIAMServiceRolesDataSource() datasource.DataSource {
+	return &IAMServiceRolesDataSource{}
+}
+
+func (d IAMServiceRolesDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_iam_service_roles"
+}
+
+func (d *IAMServiceRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type IAMServiceRolesDataSourceModel struct {
+	FullResourceName types.String          `tfsdk:"full_resource_name"`
+	Roles            []IAMServiceRoleModel `tfsdk:"roles"`
+}
+
+type IAMServiceRoleModel struct {
+	Name        types.String `tfsdk:"name"`
+	Title       types.String `tfsdk:"title"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (d IAMServiceRolesDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Роли, доступные для назначения на ресурс, например сервисным аккаунтам проекта.",
+		Attributes: map[string]schema.Attribute{
+			"full_resource_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "Полное имя ресурса. " +
+					"По умолчанию проект провайдера: resource-manager/<project_name>.",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Доступные роли.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Имя роли.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Отображаемое название роли.",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Описание роли.",
+						},
+						"type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Тип роли.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d IAMServiceRolesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data IAMServiceRolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FullResourceName.IsNull() || data.FullResourceName.ValueString() == "" {
+		data.FullResourceName = types.StringValue("resource-manager/" + d.client.ProjectName)
+	}
+
+	roles, err := iam.GetAvailableServiceRoles(d.client.Creds, data.FullResourceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get available roles for '%s'.\nError: %s",
+				data.FullResourceName.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	data.Roles = []IAMServiceRoleModel{}
+	for _, role := range roles {
+		data.Roles = append(data.Roles, IAMServiceRoleModel{
+			Name:        types.StringValue(role.Name),
+			Title:       types.StringValue(role.Title),
+			Description: types.StringValue(role.Description),
+			Type:        types.StringValue(role.Type),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,160 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/iam"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &ServiceAccountsDataSource{}
+)
+
+type ServiceAccountsDataSource struct {
+	client *client.CloudClient
+}
+
+func NewServiceAccountsDataSource() datasource.DataSource {
+	return &ServiceAccountsDataSource{}
+}
+
+func (d ServiceAccountsDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_service_accounts"
+}
+
+func (d *ServiceAccountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+type ServiceAccountsDataSourceModel struct {
+	Project         types.String                  `tfsdk:"project"`
+	ServiceAccounts []ServiceAccountDataItemModel `tfsdk:"service_accounts"`
+}
+
+type ServiceAccountDataItemModel struct {
+	Name         types.String `tfsdk:"name"`
+	Title        types.String `tfsdk:"title"`
+	Roles        []string     `tfsdk:"roles"`
+	ClientID     types.String `tfsdk:"client_id"`
+	CreatorEmail types.String `tfsdk:"creator_email"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (d ServiceAccountsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Сервисные аккаунты проекта.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Проект. По умолчанию проект провайдера.",
+			},
+			"service_accounts": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Сервисные аккаунты проекта.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Имя сервисного аккаунта.",
+						},
+						"title": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Отображаемое название сервисного аккаунта.",
+						},
+						"roles": schema.SetAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "Роли сервисного аккаунта в проекте.",
+						},
+						"client_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Идентификатор клиента для авторизации в портале.",
+						},
+						"creator_email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Почта создателя сервисного аккаунта.",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Дата создания.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d ServiceAccountsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data ServiceAccountsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Project.IsNull() || data.Project.ValueString() == "" {
+		data.Project = types.StringValue(d.client.ProjectName)
+	}
+
+	serviceAccounts, err := iam.GetServiceAccounts(d.client.Creds, data.Project.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get service accounts of project '%s'.\nError: %s",
+				data.Project.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	data.ServiceAccounts = []ServiceAccountDataItemModel{}
+	for i := range serviceAccounts {
+		serviceAccount := &serviceAccounts[i]
+		data.ServiceAccounts = append(data.ServiceAccounts, ServiceAccountDataItemModel{
+			Name:         types.StringValue(serviceAccount.Name),
+			Title:        types.StringValue(serviceAccount.Title),
+			Roles:        serviceAccountRoles(serviceAccount),
+			ClientID:     types.StringValue(clientID(serviceAccount)),
+			CreatorEmail: types.StringValue(serviceAccount.Creator.Email),
+			CreatedAt:    types.StringValue(serviceAccount.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
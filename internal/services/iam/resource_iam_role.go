@@ -0,0 +1,305 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/iam"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &IAMRoleResource{}
+	_ resource.ResourceWithImportState = &IAMRoleResource{}
+)
+
+type IAMRoleResource struct {
+	client *client.CloudClient
+}
+
+func NewIAMRoleResource() resource.Resource {
+	return &IAMRoleResource{}
+}
+
+func (r IAMRoleResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_iam_role"
+}
+
+func (r *IAMRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type IAMRoleModel struct {
+	Organization types.String `tfsdk:"organization"`
+	Name         types.String `tfsdk:"name"`
+	FullName     types.String `tfsdk:"full_name"`
+	Title        types.String `tfsdk:"title"`
+	Description  types.String `tfsdk:"description"`
+	Permissions  []string     `tfsdk:"permissions"`
+}
+
+func (r IAMRoleResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Пользовательская роль организации в IAM.",
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Организация роли. По умолчанию организация проекта провайдера.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Имя роли.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^[a-z0-9-]+$"),
+						"must only contain: lowercase characters, numbers and '-'",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Полное имя роли: organizations/<organization>/roles/<name>.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Отображаемое название роли.",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "Описание роли.",
+			},
+			"permissions": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Разрешения роли, например accountmanager:accounts:get.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r IAMRoleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan IAMRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Organization.IsNull() || plan.Organization.IsUnknown() {
+		plan.Organization = types.StringValue(r.client.Organization)
+	}
+
+	err := iam.CreateRole(
+		r.client.Creds,
+		plan.Organization.ValueString(),
+		iam.CreateRoleAttrs{
+			Name:        plan.Name.ValueString(),
+			Title:       plan.Title.ValueString(),
+			Description: plan.Description.ValueString(),
+			Permissions: plan.Permissions,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf("Role '%s' wasn't created.\nError: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.FullName = types.StringValue(iam.RoleFullName(plan.Organization.ValueString(), plan.Name.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r IAMRoleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state IAMRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := iam.GetOrganizationRoleByName(
+		r.client.Creds,
+		state.Organization.ValueString(),
+		state.Name.ValueString(),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't read role '%s'.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	readRole(&state, role)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r IAMRoleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state IAMRoleModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := iam.UpdateRolePermissions(
+		r.client.Creds,
+		state.Organization.ValueString(),
+		state.Name.ValueString(),
+		iam.UpdateRoleAttrs{
+			Title:       plan.Title.ValueString(),
+			Description: plan.Description.ValueString(),
+			Permissions: plan.Permissions,
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf("Role '%s' wasn't updated.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.Organization = state.Organization
+	plan.FullName = state.FullName
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r IAMRoleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state IAMRoleModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := iam.DeleteRole(
+		r.client.Creds,
+		state.Organization.ValueString(),
+		state.Name.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf("Role '%s' wasn't deleted.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ImportState принимает имя роли, <organization>/<name> или organizations/<organization>/roles/<name>
+func (r IAMRoleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var organization string
+	if r.client != nil {
+		organization = r.client.Organization
+	}
+	name := req.ID
+
+	parts := strings.Split(strings.Trim(req.ID, "/"), "/")
+	switch {
+	case len(parts) == 4 && parts[0] == "organizations" && parts[2] == "roles":
+		organization, name = parts[1], parts[3]
+	case len(parts) == 2:
+		organization, name = parts[0], parts[1]
+	case len(parts) != 1:
+		resp.Diagnostics.AddError(
+			"Import resource",
+			fmt.Sprintf(
+				"Unexpected role id '%s'. Expected <name>, <organization>/<name> "+
+					"or organizations/<organization>/roles/<name>",
+				req.ID,
+			),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization"), organization)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func readRole(state *IAMRoleModel, role *entities.IAMRole) {
+	permissions := append([]string{}, role.Permissions...)
+	sort.Strings(permissions)
+
+	state.FullName = types.StringValue(iam.RoleFullName(state.Organization.ValueString(), state.Name.ValueString()))
+	state.Title = types.StringValue(role.Title)
+	state.Description = types.StringValue(role.Description)
+	state.Permissions = permissions
+}
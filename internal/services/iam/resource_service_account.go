@@ -0,0 +1,319 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/iam"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &ServiceAccountResource{}
+	_ resource.ResourceWithImportState = &ServiceAccountResource{}
+)
+
+type ServiceAccountResource struct {
+	client *client.CloudClient
+}
+
+func NewServiceAccountResource() resource.Resource {
+	return &ServiceAccountResource{}
+}
+
+func (r ServiceAccountResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (r *ServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type ServiceAccountModel struct {
+	Project         types.String `tfsdk:"project"`
+	Name            types.String `tfsdk:"name"`
+	Title           types.String `tfsdk:"title"`
+	Roles           []string     `tfsdk:"roles"`
+	ClientID        types.String `tfsdk:"client_id"`
+	ClientSecret    types.String `tfsdk:"client_secret"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+}
+
+func (r ServiceAccountResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Сервисный аккаунт проекта и его ключ API.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Проект сервисного аккаунта. По умолчанию проект провайдера.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Имя сервисного аккаунта, назначается порталом.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Отображаемое название сервисного аккаунта.",
+			},
+			"roles": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Роли сервисного аккаунта в проекте, например roles/admin.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Идентификатор клиента для авторизации в портале.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+				MarkdownDescription: "Секрет клиента. Портал возвращает его только при создании аккаунта, " +
+					"поэтому после импорта значение пустое до следующей ротации.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Произвольное значение, например дата ротации. Изменение пересоздает " +
+					"сервисный аккаунт с новым ключом API: имя и client_id аккаунта меняются, " +
+					"а прежний ключ перестает действовать вместе с удаленным аккаунтом.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r ServiceAccountResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ServiceAccountModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Project.IsNull() || plan.Project.IsUnknown() {
+		plan.Project = types.StringValue(r.client.ProjectName)
+	}
+
+	serviceAccount, err := iam.NewServiceAccount(r.client.Creds, plan.Project.ValueString(), plan.Title.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	serviceAccount.Roles = plan.Roles
+
+	created, err := serviceAccount.Create()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf("Service account '%s' wasn't created.\nError: %s", plan.Title.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.Name = types.StringValue(created.Name)
+	plan.ClientID = types.StringValue(clientID(created))
+	plan.ClientSecret = types.StringValue(created.ApiKey.ClientSecret)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r ServiceAccountResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ServiceAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccount, err := iam.GetServiceAccountByName(
+		r.client.Creds,
+		state.Project.ValueString(),
+		state.Name.ValueString(),
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Can't read service account '%s'.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state.Title = types.StringValue(serviceAccount.Title)
+	state.Roles = serviceAccountRoles(serviceAccount)
+	state.ClientID = types.StringValue(clientID(serviceAccount))
+	if state.ClientSecret.IsNull() {
+		state.ClientSecret = types.StringValue("")
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r ServiceAccountResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ServiceAccountModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Project = state.Project
+	plan.Name = state.Name
+	plan.ClientID = state.ClientID
+	plan.ClientSecret = state.ClientSecret
+
+	serviceAccount, err := iam.NewServiceAccount(r.client.Creds, state.Project.ValueString(), plan.Title.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(consts.UPDATE_RES_FAIL, err.Error())
+		return
+	}
+	serviceAccount.Roles = plan.Roles
+
+	err = serviceAccount.Update(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf("Service account '%s' wasn't updated.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r ServiceAccountResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ServiceAccountModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceAccount, err := iam.NewServiceAccount(r.client.Creds, state.Project.ValueString(), state.Title.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(consts.DELETE_RES_FAIL, err.Error())
+		return
+	}
+
+	err = serviceAccount.Delete(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf("Service account '%s' wasn't deleted.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ImportState принимает имя сервисного аккаунта или <project>/<name>
+func (r ServiceAccountResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	var project string
+	if r.client != nil {
+		project = r.client.ProjectName
+	}
+	name := req.ID
+
+	parts := strings.Split(strings.Trim(req.ID, "/"), "/")
+	switch len(parts) {
+	case 1:
+	case 2:
+		project, name = parts[0], parts[1]
+	default:
+		resp.Diagnostics.AddError(
+			"Import resource",
+			fmt.Sprintf("Unexpected service account id '%s'. Expected <name> or <project>/<name>", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), project)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func serviceAccountRoles(serviceAccount *entities.ServiceAccount) []string {
+	roles := make([]string, 0, len(serviceAccount.Roles))
+	for _, role := range serviceAccount.Roles {
+		roles = append(roles, role.Name)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// clientID идентификатор клиента. Если портал не вернул client_id, им служит имя аккаунта
+func clientID(serviceAccount *entities.ServiceAccount) string {
+	if serviceAccount.ApiKey.ClientId != "" {
+		return serviceAccount.ApiKey.ClientId
+	}
+	return serviceAccount.Name
+}
@@ -108,6 +108,8 @@ type K8sClusterModel struct {
 	Products     []types.String                `tfsdk:"products"`
 	Visibility   types.Bool                    `tfsdk:"visibility"`
 	GslbOnly     types.Bool                    `tfsdk:"gslb_only"`
+
+	PlannedActions types.List `tfsdk:"planned_actions"`
 }
 
 type K8sClusterVersionModel struct {
@@ -341,6 +343,7 @@ func (t K8sClusterResource) Schema(
 				Optional:            true,
 				MarkdownDescription: "Балансировка gslb only",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -704,11 +707,15 @@ func (r K8sClusterResource) Create(
 		return
 	}
 
-	itemData := orderItem.Data
-	itemDataConfig := itemData.Config.(entities.K8sClusterConfig)
+	itemDataConfig, err := entities.ItemConfig[entities.K8sClusterConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.Name = types.StringValue(itemDataConfig.Name)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	_, _, _, _, err = setComputedNames(&plan, order)
 	if err != nil {
@@ -801,7 +808,7 @@ func (r K8sClusterResource) Create(
 		}
 	}
 
-	firstRegionData := ConvertRegionToModel(orderItem.Data.Config.(entities.K8sClusterConfig).Regions[0], true)
+	firstRegionData := ConvertRegionToModel(itemDataConfig.Regions[0], true)
 
 	var planFirstRegion, stateFirstRegion []K8sClusterRegionModel
 	planFirstRegion = append(planFirstRegion, plan.Regions[0])
@@ -1000,6 +1007,7 @@ func (r K8sClusterResource) Read(
 		ControlPlane:         ConvertControlPlaneToModel(config.ControlPlane),
 		Products:             getListProductsFromData(config.Products),
 		GslbOnly:             ConvertGslbOnlyToModel(config.GslbOnly),
+		PlannedActions:       utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var visibility types.Bool
@@ -1025,7 +1033,11 @@ func (r K8sClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "*kubernetes_v1*"}},
+		req, resp,
+	)
 }
 
 func (r K8sClusterResource) Update(
@@ -1042,6 +1054,17 @@ func (r K8sClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetK8sClusterOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -1244,6 +1267,11 @@ func (r K8sClusterResource) Update(
 		resp.Diagnostics.Append(r.handleIngressModifications(plan.Ingress, state.Ingress, order, config)...)
 		resp.State.SetAttribute(ctx, path.Root("ingress"), plan.Ingress)
 	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.State.SetAttribute(ctx, path.Root("planned_actions"), plan.PlannedActions)
 }
 
 func (r K8sClusterResource) ModifyPlan(
@@ -1259,6 +1287,9 @@ func (r K8sClusterResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -1279,6 +1310,12 @@ func (r K8sClusterResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r K8sClusterResource) BalancerDnsZoneModifyPlan(
@@ -2061,6 +2098,150 @@ func (r K8sClusterResource) configureVisibilityK8sCluster(
 	return
 }
 
+// plannedActions действия портала в порядке их вызова в Update.
+// Имена указаны без префикса версии kubernetes_v1_<minor>_cluster_
+func plannedActions(state, plan *K8sClusterModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.Version.ProductVersion.Equal(state.Version.ProductVersion) {
+		actions.AddDisruptive("update", "Обновление версии кластера с поочередным перезапуском узлов")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.Label.Equal(state.Label) {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	for _, modified := range findCPComponentDiff(plan.ControlPlane, state.ControlPlane) {
+		role := modified.Component.(K8sClusterControlPlaneModel).Role.ValueString()
+		switch {
+		case modified.Change == "flavor":
+			actions.AddDisruptive(role+"_flavor", fmt.Sprintf("Изменение конфигурации ВМ %s с перезапуском узлов", role))
+		case modified.Change == "nodes" && modified.Action == "add":
+			actions.Add(role+"_add_nodes", fmt.Sprintf("Горизонтальное масштабирование %s", role))
+		}
+	}
+
+	var stateIstio *K8sClusterIstioModel
+	if state.Components != nil {
+		stateIstio = state.Components.Istio
+	}
+	if plan.Components != nil && plan.Components.Istio != nil && !reflect.DeepEqual(plan.Components.Istio, stateIstio) {
+		if stateIstio == nil {
+			actions.Add("add_istio", "Установка Istio")
+			if len(plan.Components.Istio.ControlPlanes) > 1 {
+				actions.Add("create_istio_control_plane", "Создание control plane Istio")
+			}
+		} else {
+			if isIstioOptionsChanged(*plan, *state) || isIstioFlavorChanged(*plan, *state) {
+				actions.Add("configure_istio_options", "Изменение параметров Istio")
+			}
+			added, _, modified := findIstioCPDiff(plan.Components.Istio.ControlPlanes, stateIstio.ControlPlanes)
+			if len(added) > 0 {
+				actions.Add("create_istio_control_plane", "Создание control plane Istio")
+			}
+			if len(modified) > 0 {
+				actions.Add("configure_istio_control_plane", "Изменение control plane Istio")
+			}
+		}
+	}
+
+	componentsChanged := (plan.Components == nil) != (state.Components == nil) ||
+		plan.Components != nil && isComponentsChanged(plan.Components, state.Components)
+	if componentsChanged {
+		toAdd, toDelete := findClusterComponentsDiff(plan.Components, state.Components)
+		for _, component := range toAdd {
+			actions.Add("add_"+component.Name, fmt.Sprintf("Установка компонента %s", component.Name))
+		}
+		for _, component := range toDelete {
+			actions.Add("delete_"+component.Name, fmt.Sprintf("Удаление компонента %s", component.Name))
+		}
+	}
+
+	if !plan.ContainerCPURatio.Equal(state.ContainerCPURatio) || !plan.ContainerMemoryRatio.Equal(state.ContainerMemoryRatio) {
+		actions.Add("requests_ratio_config", "Изменение коэффициентов запросов контейнеров")
+	}
+
+	if len(plan.Products) != len(state.Products) {
+		actions.Add("products", "Изменение списка продуктов кластера")
+	}
+
+	if !(plan.Visibility.IsNull() || plan.Visibility.IsUnknown()) && state.Visibility.IsNull() {
+		actions.Add("visibility", "Включение видимости кластера")
+	}
+
+	if !reflect.DeepEqual(plan.Regions, state.Regions) {
+		added, _, modifiedRegions := findRegionIngressDiff(
+			plan.Regions, state.Regions,
+			func(r K8sClusterRegionModel) types.String {
+				return r.Name
+			},
+			func(plan, state K8sClusterRegionModel) ([]ComponentModification, bool) {
+				mods := RegionHasChanged(plan, state)
+				return mods, len(mods) > 0
+			},
+		)
+		for _, region := range added {
+			actions.Add("add_region", fmt.Sprintf("Добавление региона %s", region.Name.ValueString()))
+		}
+		for _, modified := range modifiedRegions {
+			addRegionIngressActions(&actions, "region", modified)
+		}
+	}
+
+	if !reflect.DeepEqual(plan.Ingress, state.Ingress) {
+		added, _, modifiedIngress := findRegionIngressDiff(
+			plan.Ingress, state.Ingress,
+			func(i K8sClusterIngressModel) types.String {
+				return i.Name
+			},
+			func(plan, state K8sClusterIngressModel) ([]ComponentModification, bool) {
+				mods := IngressHasChanged(plan, state)
+				return mods, len(mods) > 0
+			},
+		)
+		for _, ingress := range added {
+			actions.Add("add_ingress", fmt.Sprintf("Добавление ingress %s", ingress.Name.ValueString()))
+		}
+		for _, modified := range modifiedIngress {
+			addRegionIngressActions(&actions, "ingress", modified)
+		}
+	}
+	return actions
+}
+
+// addRegionIngressActions действия handleRegionsModifications и handleIngressModifications
+func addRegionIngressActions(actions *utils.PlannedActions, component string, modified ComponentModification) {
+	switch modified.Change {
+	case "flavor":
+		actions.AddDisruptive(component+"_flavor", fmt.Sprintf("Изменение конфигурации ВМ %s с перезапуском узлов", component))
+	case "nodes":
+		if modified.Action == "add" {
+			actions.Add(
+				component+"_add_nodes",
+				fmt.Sprintf("Горизонтальное масштабирование %s на %d узлов", component, modified.Count),
+			)
+			if component == "region" {
+				actions.Add("region_uncordon_nodes", "Ввод новых узлов региона в работу")
+			}
+		}
+	case "requests_ratio_config":
+		actions.Add("region_requests_ratio_config", "Изменение коэффициентов запросов контейнеров региона")
+	case "configure":
+		actions.Add(
+			fmt.Sprintf("%s_%s_%s", component, modified.Change, modified.Action),
+			fmt.Sprintf("Настройка компонента %s региона", modified.Action),
+		)
+	case "iscodes":
+		actions.Add("region_set_iscodes", "Изменение кодов ИС региона")
+	case "settings":
+		actions.Add("ingress_settings", "Изменение настроек ingress")
+	}
+}
+
 func (r K8sClusterResource) handleControlPlaneAction(
 	planCP, stateCP []K8sClusterControlPlaneModel,
 	order *orders.K8sClusterOrder,
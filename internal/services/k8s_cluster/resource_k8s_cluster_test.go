@@ -0,0 +1,112 @@
+package k8scluster
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-vtb/internal/services/flavor"
+	"terraform-provider-vtb/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testK8sClusterModel() K8sClusterModel {
+	return K8sClusterModel{
+		Label:            types.StringValue("cluster"),
+		FinancialProject: types.StringValue("fin-project"),
+		Version: K8sClusterVersionModel{
+			K8sVersion:     types.StringValue("1.28"),
+			ProductVersion: types.StringValue("1.28.3"),
+		},
+		Components: &K8sClusterComponentsModel{},
+		ControlPlane: []K8sClusterControlPlaneModel{{
+			Role:   types.StringValue("infra"),
+			Size:   types.Int64Value(2),
+			Flavor: flavor.FlavorModel{Name: types.StringValue("c4m8")},
+		}},
+		Regions: []K8sClusterRegionModel{{
+			Name:   types.StringValue("region-01"),
+			Size:   types.Int64Value(3),
+			Flavor: flavor.FlavorModel{Name: types.StringValue("c8m16")},
+		}},
+		Ingress: []K8sClusterIngressModel{{
+			Name:   types.StringValue("ingress-01"),
+			Size:   types.Int64Value(2),
+			Flavor: flavor.FlavorModel{Name: types.StringValue("c4m8")},
+		}},
+	}
+}
+
+func plannedActionNames(actions utils.PlannedActions) []string {
+	names := []string{}
+	for _, action := range actions {
+		names = append(names, action.Name)
+	}
+	return names
+}
+
+func TestPlannedActions(t *testing.T) {
+	cases := []struct {
+		name           string
+		modify         func(plan *K8sClusterModel)
+		wantNames      []string
+		wantDisruptive bool
+	}{
+		{
+			name:      "no changes",
+			modify:    func(plan *K8sClusterModel) {},
+			wantNames: []string{},
+		},
+		{
+			name: "version upgrade",
+			modify: func(plan *K8sClusterModel) {
+				plan.Version.ProductVersion = types.StringValue("1.29.1")
+			},
+			wantNames:      []string{"update"},
+			wantDisruptive: true,
+		},
+		{
+			name: "region horizontal scaling",
+			modify: func(plan *K8sClusterModel) {
+				plan.Regions[0].Size = types.Int64Value(5)
+			},
+			wantNames: []string{"region_add_nodes", "region_uncordon_nodes"},
+		},
+		{
+			name: "ingress flavor and scaling",
+			modify: func(plan *K8sClusterModel) {
+				plan.Ingress[0].Size = types.Int64Value(3)
+				plan.Ingress[0].Flavor = flavor.FlavorModel{Name: types.StringValue("c8m16")}
+			},
+			wantNames:      []string{"ingress_flavor", "ingress_add_nodes"},
+			wantDisruptive: true,
+		},
+		{
+			name: "control plane scaling and new region",
+			modify: func(plan *K8sClusterModel) {
+				plan.ControlPlane[0].Size = types.Int64Value(3)
+				plan.Regions = append(plan.Regions, K8sClusterRegionModel{
+					Name: types.StringValue("region-02"),
+					Size: types.Int64Value(2),
+				})
+			},
+			wantNames: []string{"infra_add_nodes", "add_region"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := testK8sClusterModel()
+			plan := testK8sClusterModel()
+			c.modify(&plan)
+
+			actions := plannedActions(&state, &plan)
+			if names := plannedActionNames(actions); !reflect.DeepEqual(names, c.wantNames) {
+				t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+			}
+			if actions.HasDisruptive() != c.wantDisruptive {
+				t.Fatalf("Expected disruptive=%v for %v", c.wantDisruptive, actions)
+			}
+		})
+	}
+}
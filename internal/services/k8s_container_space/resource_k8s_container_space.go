@@ -323,7 +323,11 @@ func (r K8sContainerSpaceResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "container_space", Provider: "kubernetes"}},
+		req, resp,
+	)
 }
 
 func (r K8sContainerSpaceResource) Create(
@@ -508,8 +512,11 @@ func (r K8sContainerSpaceResource) Create(
 	}
 	resp.Diagnostics.Append(diags...)
 
-	itemData := orderItem.Data
-	itemDataConfig := itemData.Config.(entities.K8sContainerSpaceConfig)
+	itemDataConfig, err := entities.ItemConfig[entities.K8sContainerSpaceConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.Name = types.StringValue(itemDataConfig.Name)
@@ -704,7 +711,11 @@ func (r K8sContainerSpaceResource) Read(
 		return
 	}
 
-	config := item.Data.Config.(entities.K8sContainerSpaceConfig)
+	config, err := entities.ItemConfig[entities.K8sContainerSpaceConfig](item)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	state := K8sContainerSpaceModel{
 		OrderID:          orderID,
@@ -880,6 +891,9 @@ func (r K8sContainerSpaceResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
 	"terraform-provider-vtb/internal/consts"
 	"terraform-provider-vtb/internal/custommodifires"
 	"terraform-provider-vtb/internal/utils"
@@ -127,6 +128,7 @@ type K8sProjectModel struct {
 	TsdsOperator     *K8sProjectComponentData     `tfsdk:"tsds_operator"`
 	OmniCertificates []K8sProjectOmniData         `tfsdk:"omni_certificates"`
 	ChaosMesh        *K8sProjectComponentData     `tfsdk:"chaos_mesh"`
+	PlannedActions   types.List                   `tfsdk:"planned_actions"`
 }
 
 func (t K8sProjectResource) Schema(
@@ -437,6 +439,7 @@ func (t K8sProjectResource) Schema(
 					int64planmodifier.RequiresReplace(),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -600,13 +603,17 @@ func (r K8sProjectResource) Create(
 	}
 	resp.Diagnostics.Append(diags...)
 
-	itemData := orderItem.Data
-	config := itemData.Config.(entities.K8sProjectItemConfig)
+	config, err := entities.ItemConfig[entities.K8sProjectItemConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
 	projectname := config.ProjectName
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.FullProjectName = types.StringValue(projectname)
 	plan.ClusterID = types.StringValue(clusterID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -781,7 +788,11 @@ func (r K8sProjectResource) Read(
 		return
 	}
 
-	config := item.Data.Config.(entities.K8sProjectItemConfig)
+	config, err := entities.ItemConfig[entities.K8sProjectItemConfig](item)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	state := K8sProjectModel{
 		Label:            types.StringValue(order.Label),
@@ -808,6 +819,7 @@ func (r K8sProjectResource) Read(
 		Istio:            GetIstioData(config.Istio),
 		OmniCertificates: GetOmniData(config.OmniCertificates),
 		ChaosMesh:        GetComponentData(config.ChaosMesh),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -828,7 +840,11 @@ func (r K8sProjectResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "project", Provider: "kubernetes"}},
+		req, resp,
+	)
 }
 
 func (r K8sProjectResource) Update(
@@ -843,6 +859,20 @@ func (r K8sProjectResource) Update(
 	resp.Diagnostics.Append(diags...)
 	diags = resp.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetK8sProjectOrder(
 		r.client.Creds,
@@ -994,6 +1024,9 @@ func (r K8sProjectResource) ModifyPlan(
 	var plan K8sProjectModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	var state K8sProjectModel
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -1024,7 +1057,15 @@ func (r K8sProjectResource) ModifyPlan(
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r K8sProjectResource) ClusterDataPlan(
@@ -1418,6 +1459,72 @@ type OrderHandler interface {
 	K8sProjectDeleteOmniCertificate(ctx context.Context, name, component string) error
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *K8sProjectModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.Label.Equal(state.Label) {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !reflect.DeepEqual(plan.Quota, state.Quota) || !reflect.DeepEqual(plan.Access, state.Access) {
+		actions.Add("update_kubernetes_project", "Изменение квот и ролей проекта")
+	}
+
+	plannedComponentActions(&actions, plan, state)
+	return actions
+}
+
+// plannedComponentActions действия с компонентами проекта в порядке их вызова в Update.
+// Компоненты с ролями перечисляются в фиксированном порядке, Update обходит их в порядке map
+func plannedComponentActions(actions *utils.PlannedActions, plan, state K8sComponentData) {
+	for _, component := range []string{"tsds_operator", "chaos_mesh"} {
+		planComponent, stateComponent := plan.GetComponent(component), state.GetComponent(component)
+		if stateComponent == nil && planComponent != nil {
+			actions.Add("kubernetes_project_add_"+component, "Добавление компонента "+component)
+		} else if stateComponent != nil && planComponent == nil {
+			actions.Add("kubernetes_project_delete_"+component, "Удаление компонента "+component)
+		}
+	}
+
+	for _, component := range []string{"tyk", "tslg_operator", "tsam_operator", "istio"} {
+		var planComponent, stateComponent ComponentData
+		if component == "istio" {
+			planComponent, stateComponent = plan.GetIstio(), state.GetIstio()
+		} else {
+			planComponent, stateComponent = plan.GetFullComponent(component), state.GetFullComponent(component)
+		}
+
+		planIsNil := reflect.ValueOf(planComponent).IsNil()
+		stateIsNil := reflect.ValueOf(stateComponent).IsNil()
+
+		switch {
+		case planIsNil && stateIsNil:
+		case stateIsNil:
+			actions.Add("kubernetes_project_add_"+component, "Добавление компонента "+component)
+		case planIsNil:
+			actions.Add("kubernetes_project_delete_"+component, "Удаление компонента "+component)
+		case component == "istio" && planComponent.GetControlPlane() != stateComponent.GetControlPlane():
+			actions.Add("kubernetes_project_delete_"+component, "Удаление компонента "+component)
+			actions.Add("kubernetes_project_add_"+component, "Добавление компонента "+component)
+		case IsComponentRolesChanged(planComponent.GetRoles(), stateComponent.GetRoles()):
+			actions.Add("kubernetes_project_update_"+component, "Изменение ролей компонента "+component)
+		}
+	}
+
+	addedCerts, deletedCerts := FindCertsDiff(plan.GetOmni(), state.GetOmni())
+	if len(deletedCerts) > 0 {
+		actions.Add("kubernetes_project_delete_omni_certificate", "Удаление сертификатов Omni")
+	}
+	if len(addedCerts) > 0 {
+		actions.Add("kubernetes_project_add_omni_certificate", "Добавление сертификатов Omni")
+	}
+}
+
 func HandleFullComponentAction(
 	ctx context.Context,
 	plan, state K8sComponentData,
@@ -0,0 +1,85 @@
+package k8sproject
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/entities"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedActions(t *testing.T) {
+	roles := []entities.RolesK8sProject{{Role: "view", Groups: []string{"group-a"}}}
+
+	cases := []struct {
+		name      string
+		modify    func(plan *K8sProjectModel)
+		wantNames []string
+	}{
+		{
+			name:      "no changes",
+			modify:    func(plan *K8sProjectModel) {},
+			wantNames: nil,
+		},
+		{
+			name: "quota and components",
+			modify: func(plan *K8sProjectModel) {
+				plan.Quota = K8sProjectQuotaData{CPU: types.Int64Value(4), Memory: types.Int64Value(8)}
+				plan.ChaosMesh = &K8sProjectComponentData{Namespace: types.StringValue("chaos")}
+				plan.Tyk = nil
+				plan.TsamOperator = &K8sProjectFullComponentData{Roles: roles}
+			},
+			wantNames: []string{
+				"update_kubernetes_project",
+				"kubernetes_project_add_chaos_mesh",
+				"kubernetes_project_delete_tyk",
+				"kubernetes_project_add_tsam_operator",
+			},
+		},
+		{
+			name: "istio control plane replaces component",
+			modify: func(plan *K8sProjectModel) {
+				plan.Istio = &K8sProjectIstioData{ControlPlane: types.StringValue("cp-2"), Roles: roles}
+			},
+			wantNames: []string{"kubernetes_project_delete_istio", "kubernetes_project_add_istio"},
+		},
+		{
+			name: "roles and omni certificates",
+			modify: func(plan *K8sProjectModel) {
+				plan.Tyk = &K8sProjectFullComponentData{
+					Roles: []entities.RolesK8sProject{{Role: "edit", Groups: []string{"group-a"}}},
+				}
+				plan.OmniCertificates = []K8sProjectOmniData{{AppName: "app-b", ClientName: "client-b"}}
+			},
+			wantNames: []string{
+				"kubernetes_project_update_tyk",
+				"kubernetes_project_delete_omni_certificate",
+				"kubernetes_project_add_omni_certificate",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := K8sProjectModel{
+				Label:            types.StringValue("label"),
+				FinancialProject: types.StringValue("finproj"),
+				Quota:            K8sProjectQuotaData{CPU: types.Int64Value(2), Memory: types.Int64Value(4)},
+				Tyk:              &K8sProjectFullComponentData{Roles: roles},
+				Istio:            &K8sProjectIstioData{ControlPlane: types.StringValue("cp-1"), Roles: roles},
+				OmniCertificates: []K8sProjectOmniData{{AppName: "app-a", ClientName: "client-a"}},
+			}
+			plan := state
+			c.modify(&plan)
+
+			var names []string
+			for _, action := range plannedActions(&state, &plan) {
+				names = append(names, action.Name)
+			}
+			if !reflect.DeepEqual(names, c.wantNames) {
+				t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package kafka
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	clientCNRegexp = regexp.MustCompile(`^[a-zA-Z0-9_\-@\.]+$`)
+	yamlLineRegexp = regexp.MustCompile(`line (\d+): (.*)`)
+
+	catalogTopicKeys = []string{
+		"name",
+		"partitions",
+		"cleanup_policy",
+		"retention_ms",
+		"retention_bytes",
+		"segment_size_mb",
+		"compression_type",
+		"producers",
+		"consumers",
+	}
+)
+
+// catalogTopic топик каталога. Необязательные числовые поля - указатели,
+// чтобы отличать отсутствие значения от нуля
+type catalogTopic struct {
+	Name            string   `yaml:"name"`
+	Partitions      *int64   `yaml:"partitions"`
+	CleanupPolicy   string   `yaml:"cleanup_policy"`
+	RetentionMs     *int64   `yaml:"retention_ms"`
+	RetentionBytes  *int64   `yaml:"retention_bytes"`
+	SegmentSizeMb   *int64   `yaml:"segment_size_mb"`
+	CompressionType string   `yaml:"compression_type"`
+	Producers       []string `yaml:"producers"`
+	Consumers       []string `yaml:"consumers"`
+
+	line int
+}
+
+// catalogError ошибка каталога с номером строки
+type catalogError struct {
+	Line    int
+	Message string
+}
+
+func (e catalogError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// parseKafkaCatalog разбирает каталог топиков в формате YAML или JSON и проверяет его
+// по тем же правилам, что и ресурс кластера Kafka
+func parseKafkaCatalog(data []byte) ([]catalogTopic, []catalogError) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, []catalogError{yamlError(err)}
+	}
+	if len(root.Content) == 0 {
+		return nil, []catalogError{{Line: 1, Message: "catalog is empty"}}
+	}
+
+	document := root.Content[0]
+	if document.Kind != yaml.MappingNode {
+		return nil, []catalogError{{Line: document.Line, Message: "catalog must be a mapping with `topics` key"}}
+	}
+
+	var topicsNode *yaml.Node
+	var errs []catalogError
+	for i := 0; i < len(document.Content); i += 2 {
+		key := document.Content[i]
+		if key.Value == "topics" {
+			topicsNode = document.Content[i+1]
+			continue
+		}
+		errs = append(errs, catalogError{Line: key.Line, Message: fmt.Sprintf("unknown key `%s`", key.Value)})
+	}
+	if topicsNode == nil {
+		return nil, append(errs, catalogError{Line: document.Line, Message: "`topics` is required"})
+	}
+	if topicsNode.Kind != yaml.SequenceNode {
+		return nil, append(errs, catalogError{Line: topicsNode.Line, Message: "`topics` must be a list"})
+	}
+
+	var topics []catalogTopic
+	topicLines := make(map[string]int)
+	for _, node := range topicsNode.Content {
+		topic, topicErrs := parseCatalogTopic(node)
+		errs = append(errs, topicErrs...)
+		if len(topicErrs) != 0 {
+			continue
+		}
+		if line, exists := topicLines[topic.Name]; exists {
+			errs = append(errs, catalogError{
+				Line:    topic.line,
+				Message: fmt.Sprintf("topic `%s` already declared at line %d", topic.Name, line),
+			})
+			continue
+		}
+		topicLines[topic.Name] = topic.line
+		topics = append(topics, topic)
+	}
+	return topics, errs
+}
+
+func parseCatalogTopic(node *yaml.Node) (topic catalogTopic, errs []catalogError) {
+	if node.Kind != yaml.MappingNode {
+		return topic, []catalogError{{Line: node.Line, Message: "topic must be a mapping"}}
+	}
+
+	keyLines := make(map[string]int)
+	for i := 0; i < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if !slices.Contains(catalogTopicKeys, key.Value) {
+			errs = append(errs, catalogError{Line: key.Line, Message: fmt.Sprintf("unknown topic key `%s`", key.Value)})
+		}
+		keyLines[key.Value] = key.Line
+	}
+
+	if err := node.Decode(&topic); err != nil {
+		return topic, append(errs, yamlError(err))
+	}
+	topic.line = node.Line
+
+	lineOf := func(key string) int {
+		if line, ok := keyLines[key]; ok {
+			return line
+		}
+		return node.Line
+	}
+	addErr := func(key, format string, args ...any) {
+		errs = append(errs, catalogError{Line: lineOf(key), Message: fmt.Sprintf(format, args...)})
+	}
+
+	// defaults like in resource schema
+	if topic.SegmentSizeMb == nil {
+		defaultSegmentSize := int64(topicDefaultSegmentSizeMb)
+		topic.SegmentSizeMb = &defaultSegmentSize
+	}
+	if topic.CompressionType == "" {
+		topic.CompressionType = topicDefaultCompressionType
+	}
+
+	switch {
+	case topic.Name == "":
+		addErr("name", "topic `name` is required")
+	case len(topic.Name) > topicNameMaxLength:
+		addErr("name", "topic name `%s` must be at most %d characters", topic.Name, topicNameMaxLength)
+	case !topicNameRegexp.MatchString(topic.Name):
+		addErr("name", "topic name `%s` %s", topic.Name, topicNameMessage)
+	}
+
+	if topic.Partitions == nil {
+		addErr("partitions", "`partitions` is required")
+	} else if *topic.Partitions < topicPartitionsMin || *topic.Partitions > topicPartitionsMax {
+		addErr("partitions", "`partitions` must be between %d and %d, got %d",
+			topicPartitionsMin, topicPartitionsMax, *topic.Partitions)
+	}
+
+	if !slices.Contains(topicSegmentSizesMb, *topic.SegmentSizeMb) {
+		addErr("segment_size_mb", "`segment_size_mb` must be one of %v, got %d", topicSegmentSizesMb, *topic.SegmentSizeMb)
+	}
+
+	if topic.RetentionMs != nil && (*topic.RetentionMs < topicRetentionMsMin || *topic.RetentionMs > topicRetentionMsMax) {
+		addErr("retention_ms", "`retention_ms` must be between %d and %d, got %d",
+			topicRetentionMsMin, topicRetentionMsMax, *topic.RetentionMs)
+	}
+	if topic.RetentionBytes != nil &&
+		(*topic.RetentionBytes < topicRetentionBytesMin || *topic.RetentionBytes > topicRetentionBytesMax) {
+		addErr("retention_bytes", "`retention_bytes` must be between %d and %d, got %d",
+			topicRetentionBytesMin, topicRetentionBytesMax, *topic.RetentionBytes)
+	}
+
+	if !slices.Contains(topicCompressionTypes, topic.CompressionType) {
+		addErr("compression_type", "`compression_type` must be one of %v, got `%s`",
+			topicCompressionTypes, topic.CompressionType)
+	}
+
+	retentionSet := topic.RetentionMs != nil || topic.RetentionBytes != nil
+	switch topic.CleanupPolicy {
+	case "delete", "delete,compact":
+		if !retentionSet {
+			addErr("cleanup_policy", "at least one of `retention_ms`, `retention_bytes` must be specified "+
+				"when `cleanup_policy` is `%s`", topic.CleanupPolicy)
+		}
+	case "compact":
+		if retentionSet {
+			addErr("cleanup_policy", "`retention_ms` and `retention_bytes` conflict with `cleanup_policy` `compact`")
+		}
+	case "":
+		addErr("cleanup_policy", "`cleanup_policy` is required")
+	default:
+		addErr("cleanup_policy", "`cleanup_policy` must be one of %v, got `%s`", topicCleanupPolicies, topic.CleanupPolicy)
+	}
+
+	for _, key := range []string{"producers", "consumers"} {
+		clients := topic.Producers
+		if key == "consumers" {
+			clients = topic.Consumers
+		}
+		for _, clientCN := range clients {
+			if len(clientCN) == 0 || len(clientCN) > 64 || !clientCNRegexp.MatchString(clientCN) {
+				addErr(key, "client_cn `%s` must be 1-64 characters of uppercase/lowercase Latin letters, numbers, "+
+					"punctuation marks ['.' '-' '_'] and '@'", clientCN)
+			}
+		}
+	}
+	return topic, errs
+}
+
+// catalogClients возвращает топики на чтение и запись для каждого клиента каталога
+func catalogClients(topics []catalogTopic) (consumers, producers map[string][]string) {
+	consumers = make(map[string][]string)
+	producers = make(map[string][]string)
+	for _, topic := range topics {
+		for _, clientCN := range topic.Consumers {
+			if !slices.Contains(consumers[clientCN], topic.Name) {
+				consumers[clientCN] = append(consumers[clientCN], topic.Name)
+			}
+		}
+		for _, clientCN := range topic.Producers {
+			if !slices.Contains(producers[clientCN], topic.Name) {
+				producers[clientCN] = append(producers[clientCN], topic.Name)
+			}
+		}
+	}
+	for _, clientTopics := range consumers {
+		sort.Strings(clientTopics)
+	}
+	for _, clientTopics := range producers {
+		sort.Strings(clientTopics)
+	}
+	return consumers, producers
+}
+
+func yamlError(err error) catalogError {
+	message := err.Error()
+	if typeErr, ok := err.(*yaml.TypeError); ok && len(typeErr.Errors) > 0 {
+		message = typeErr.Errors[0]
+	}
+	if match := yamlLineRegexp.FindStringSubmatch(message); match != nil {
+		line, _ := strconv.Atoi(match[1])
+		return catalogError{Line: line, Message: match[2]}
+	}
+	return catalogError{Line: 1, Message: message}
+}
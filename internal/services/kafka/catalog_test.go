@@ -0,0 +1,212 @@
+package kafka
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseKafkaCatalog(t *testing.T) {
+	cases := []struct {
+		name       string
+		catalog    string
+		wantTopics []string
+		wantErrors []catalogError
+	}{
+		{
+			name: "valid yaml",
+			catalog: `topics:
+  - name: orders
+    partitions: 3
+    cleanup_policy: delete
+    retention_ms: 86400000
+    consumers: [billing]
+  - name: events.compacted
+    partitions: 1
+    cleanup_policy: compact
+    segment_size_mb: 256
+    compression_type: zstd
+`,
+			wantTopics: []string{"orders", "events.compacted"},
+		},
+		{
+			name:       "valid json",
+			catalog:    `{"topics": [{"name": "orders", "partitions": 50, "cleanup_policy": "delete,compact", "retention_bytes": 134217728}]}`,
+			wantTopics: []string{"orders"},
+		},
+		{
+			name:       "empty",
+			catalog:    ``,
+			wantErrors: []catalogError{{Line: 1, Message: "catalog is empty"}},
+		},
+		{
+			name:       "not a mapping",
+			catalog:    "\n- orders\n",
+			wantErrors: []catalogError{{Line: 2, Message: "catalog must be a mapping"}},
+		},
+		{
+			name: "unknown root key and no topics",
+			catalog: `version: 1
+`,
+			wantErrors: []catalogError{
+				{Line: 1, Message: "unknown key `version`"},
+				{Line: 1, Message: "`topics` is required"},
+			},
+		},
+		{
+			name: "topics is not a list",
+			catalog: `topics:
+  orders: {}
+`,
+			wantErrors: []catalogError{{Line: 2, Message: "`topics` must be a list"}},
+		},
+		{
+			name: "yaml syntax error",
+			catalog: `topics:
+  - name: orders
+    partitions: [
+`,
+			wantErrors: []catalogError{{Line: 3, Message: "did not find expected node content"}},
+		},
+		{
+			name: "wrong value type",
+			catalog: `topics:
+  - name: orders
+    partitions: many
+    cleanup_policy: compact
+`,
+			wantErrors: []catalogError{{Line: 3, Message: "cannot unmarshal"}},
+		},
+		{
+			name: "invalid topic values",
+			catalog: `topics:
+  - name: .orders
+    partitions: 51
+    cleanup_policy: delete
+    retention_ms: 1000
+    retention_bytes: 1000
+    segment_size_mb: 100
+    compression_type: brotli
+    consumers: ["bad client"]
+    owner: team
+  - name: events
+    partitions: 1
+    cleanup_policy: delete
+    retention_ms: 86400000
+`,
+			wantTopics: []string{"events"},
+			wantErrors: []catalogError{
+				{Line: 10, Message: "unknown topic key `owner`"},
+				{Line: 2, Message: "topic name `.orders` may contain"},
+				{Line: 3, Message: "`partitions` must be between 1 and 50, got 51"},
+				{Line: 7, Message: "`segment_size_mb` must be one of [1024 512 256 128], got 100"},
+				{Line: 5, Message: "`retention_ms` must be between 1800000 and 7776000000, got 1000"},
+				{Line: 6, Message: "`retention_bytes` must be between 134217728 and 1000000000000, got 1000"},
+				{Line: 8, Message: "`compression_type` must be one of"},
+				{Line: 9, Message: "client_cn `bad client`"},
+			},
+		},
+		{
+			name: "missing required keys",
+			catalog: `topics:
+  - segment_size_mb: 512
+`,
+			wantErrors: []catalogError{
+				{Line: 2, Message: "topic `name` is required"},
+				{Line: 2, Message: "`partitions` is required"},
+				{Line: 2, Message: "`cleanup_policy` is required"},
+			},
+		},
+		{
+			name: "cleanup policy and retention",
+			catalog: `topics:
+  - name: deleted
+    partitions: 1
+    cleanup_policy: delete
+  - name: compacted
+    partitions: 1
+    cleanup_policy: compact
+    retention_ms: 86400000
+  - name: unknown
+    partitions: 1
+    cleanup_policy: archive
+`,
+			wantErrors: []catalogError{
+				{Line: 4, Message: "at least one of `retention_ms`, `retention_bytes` must be specified"},
+				{Line: 7, Message: "`retention_ms` and `retention_bytes` conflict with `cleanup_policy` `compact`"},
+				{Line: 11, Message: "`cleanup_policy` must be one of [delete compact delete,compact], got `archive`"},
+			},
+		},
+		{
+			name: "duplicate topic",
+			catalog: `topics:
+  - name: orders
+    partitions: 1
+    cleanup_policy: compact
+  - name: orders
+    partitions: 2
+    cleanup_policy: compact
+`,
+			wantTopics: []string{"orders"},
+			wantErrors: []catalogError{{Line: 5, Message: "topic `orders` already declared at line 2"}},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			topics, errs := parseKafkaCatalog([]byte(c.catalog))
+
+			var names []string
+			for _, topic := range topics {
+				names = append(names, topic.Name)
+			}
+			if !reflect.DeepEqual(names, c.wantTopics) {
+				t.Errorf("topics = %v, want %v", names, c.wantTopics)
+			}
+
+			if len(errs) != len(c.wantErrors) {
+				t.Fatalf("errors = %v, want %v", errs, c.wantErrors)
+			}
+			for i, err := range errs {
+				want := c.wantErrors[i]
+				if err.Line != want.Line || !strings.Contains(err.Message, want.Message) {
+					t.Errorf("error %d = %v, want %v", i, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseKafkaCatalogDefaults(t *testing.T) {
+	topics, errs := parseKafkaCatalog([]byte(`topics:
+  - name: orders
+    partitions: 1
+    cleanup_policy: compact
+`))
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	topic := topics[0]
+	if *topic.SegmentSizeMb != topicDefaultSegmentSizeMb || topic.CompressionType != topicDefaultCompressionType {
+		t.Errorf("defaults = %d/%s", *topic.SegmentSizeMb, topic.CompressionType)
+	}
+	if topic.RetentionMs != nil || topic.RetentionBytes != nil {
+		t.Errorf("retention must stay unset, got %v/%v", topic.RetentionMs, topic.RetentionBytes)
+	}
+}
+
+func TestCatalogClients(t *testing.T) {
+	topics := []catalogTopic{
+		{Name: "payments", Consumers: []string{"billing"}, Producers: []string{"gateway"}},
+		{Name: "orders", Consumers: []string{"billing", "billing"}, Producers: []string{"gateway"}},
+	}
+
+	consumers, producers := catalogClients(topics)
+	if expected := map[string][]string{"billing": {"orders", "payments"}}; !reflect.DeepEqual(consumers, expected) {
+		t.Errorf("consumers = %v, want %v", consumers, expected)
+	}
+	if expected := map[string][]string{"gateway": {"orders", "payments"}}; !reflect.DeepEqual(producers, expected) {
+		t.Errorf("producers = %v, want %v", producers, expected)
+	}
+}
@@ -0,0 +1,181 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"terraform-provider-vtb/internal/consts"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource = &KafkaCatalogDataSource{}
+)
+
+type KafkaCatalogDataSource struct{}
+
+func NewKafkaCatalogDataSource() datasource.DataSource {
+	return &KafkaCatalogDataSource{}
+}
+
+func (d KafkaCatalogDataSource) Metadata(
+	ctx context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_catalog"
+}
+
+type KafkaCatalogModel struct {
+	File       types.String `tfsdk:"file"`
+	Content    types.String `tfsdk:"content"`
+	TopicNames []string     `tfsdk:"topic_names"`
+	Topics     types.Map    `tfsdk:"topics"`
+	ACLs       types.Map    `tfsdk:"acls"`
+}
+
+func (d KafkaCatalogDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Каталог топиков Kafka в формате YAML или JSON. Каталог проверяется по тем же правилам, " +
+			"что и `vtb_kafka_instance`, а результат можно напрямую передать в `topics` и `acls` кластера " +
+			"или в `for_each` ресурсов `vtb_kafka_topic` и `vtb_kafka_acl`.\n\n" +
+			"Формат каталога:\n```yaml\ntopics:\n  - name: orders-events\n    partitions: 3\n" +
+			"    cleanup_policy: delete\n    retention_ms: 86400000\n    compression_type: zstd\n" +
+			"    producers: [APD-billing]\n    consumers: [APD-analytics]\n```",
+		Attributes: map[string]schema.Attribute{
+			"file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Путь к файлу каталога.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content")),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Содержимое каталога.",
+			},
+			"topic_names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Имена топиков каталога.",
+			},
+			"topics": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: TopicModel{}.AttributeTypes()},
+				MarkdownDescription: "Топики в формате атрибута `topics` ресурса `vtb_kafka_instance`.",
+			},
+			"acls": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: ClientACLsModel{}.AttributeTypes()},
+				MarkdownDescription: "ACL клиентов (producers/consumers) в формате атрибута `acls` ресурса `vtb_kafka_instance`.",
+			},
+		},
+	}
+}
+
+func (d KafkaCatalogDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data KafkaCatalogModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sourcePath := path.Root("content")
+	content := []byte(data.Content.ValueString())
+	if !data.File.IsNull() {
+		sourcePath = path.Root("file")
+		var err error
+		content, err = os.ReadFile(data.File.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				sourcePath,
+				consts.READ_RES_FAIL,
+				fmt.Sprintf("Can't read catalog file '%s'.\nError: %s", data.File.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
+	catalogTopics, errs := parseKafkaCatalog(content)
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(sourcePath, "Invalid kafka catalog", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topics := make(map[string]TopicModel)
+	data.TopicNames = []string{}
+	for _, topic := range catalogTopics {
+		data.TopicNames = append(data.TopicNames, topic.Name)
+		topics[topic.Name] = TopicModel{
+			CleanupPolicy:      types.StringValue(topic.CleanupPolicy),
+			Partitions:         types.Int64PointerValue(topic.Partitions),
+			SegmentSizeMb:      types.Int64PointerValue(topic.SegmentSizeMb),
+			RetentionMs:        types.Int64PointerValue(topic.RetentionMs),
+			RetentionBytes:     types.Int64PointerValue(topic.RetentionBytes),
+			CompressionType:    types.StringValue(topic.CompressionType),
+			AllowTopicRecreate: types.BoolValue(false),
+		}
+	}
+	sort.Strings(data.TopicNames)
+
+	consumers, producers := catalogClients(catalogTopics)
+	acls := make(map[string]ClientACLsModel)
+	for clientCN := range consumers {
+		acls[clientCN] = ClientACLsModel{}
+	}
+	for clientCN := range producers {
+		acls[clientCN] = ClientACLsModel{}
+	}
+	emptySet := types.SetValueMust(types.StringType, nil)
+	for clientCN := range acls {
+		consumerByName, diags := types.SetValueFrom(ctx, types.StringType, consumers[clientCN])
+		resp.Diagnostics.Append(diags...)
+		producerByName, diags := types.SetValueFrom(ctx, types.StringType, producers[clientCN])
+		resp.Diagnostics.Append(diags...)
+		if consumers[clientCN] == nil {
+			consumerByName = emptySet
+		}
+		if producers[clientCN] == nil {
+			producerByName = emptySet
+		}
+		acls[clientCN] = ClientACLsModel{
+			Idempotent:          types.BoolValue(false),
+			ConsumerByName:      consumerByName,
+			ProducerByName:      producerByName,
+			ConsumerByMask:      emptySet,
+			ProducerByMask:      emptySet,
+			TransactionalByName: emptySet,
+			TransactionalByMask: emptySet,
+		}
+	}
+
+	topicsValue, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: TopicModel{}.AttributeTypes()}, topics)
+	resp.Diagnostics.Append(diags...)
+	aclsValue, diags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: ClientACLsModel{}.AttributeTypes()}, acls)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Topics = topicsValue
+	data.ACLs = aclsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
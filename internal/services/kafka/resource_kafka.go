@@ -56,6 +56,9 @@ var (
 	_ resource.ResourceWithModifyPlan  = KafkaResource{}
 )
 
+// kafkaItemKinds основной item заказа Kafka, по нему импорт отбирает заказы продукта
+var kafkaItemKinds = []entities.ItemKind{{Type: "cluster", Provider: "kafka"}}
+
 type KafkaResource struct {
 	client *client.CloudClient
 }
@@ -103,17 +106,24 @@ type KafkaClusterResourceModel struct {
 
 	Image KafkaImageModel `tfsdk:"image"`
 
-	LayoutID                types.String `tfsdk:"layout_id"`
-	ClusterName             types.String `tfsdk:"cluster_name"`
-	KafkaVersion            types.String `tfsdk:"kafka_version"`
-	BuildVersion            types.String `tfsdk:"build_version"`
-	RetentionMinutes        types.Int64  `tfsdk:"retention_minutes"`
-	Topics                  types.Map    `tfsdk:"topics"`
-	ACLs                    types.Map    `tfsdk:"acls"`
-	Quotas                  types.Set    `tfsdk:"quotas"`
-	FinancialProject        types.String `tfsdk:"financial_project"`
-	UpgradeKafkaDistribMode types.String `tfsdk:"upgrade_kafka_distrib_mode"`
-	ConnectionURL           types.String `tfsdk:"connection_url"`
+	LayoutID                types.String                   `tfsdk:"layout_id"`
+	ClusterName             types.String                   `tfsdk:"cluster_name"`
+	KafkaVersion            types.String                   `tfsdk:"kafka_version"`
+	BuildVersion            types.String                   `tfsdk:"build_version"`
+	RetentionMinutes        types.Int64                    `tfsdk:"retention_minutes"`
+	Topics                  types.Map                      `tfsdk:"topics"`
+	TopicsOwnership         types.String                   `tfsdk:"topics_ownership"`
+	ACLs                    types.Map                      `tfsdk:"acls"`
+	ACLsOwnership           types.String                   `tfsdk:"acls_ownership"`
+	Quotas                  types.Set                      `tfsdk:"quotas"`
+	QuotasOwnership         types.String                   `tfsdk:"quotas_ownership"`
+	FinancialProject        types.String                   `tfsdk:"financial_project"`
+	UpgradeKafkaDistribMode types.String                   `tfsdk:"upgrade_kafka_distrib_mode"`
+	ConnectionURL           types.String                   `tfsdk:"connection_url"`
+	PowerState              types.String                   `tfsdk:"power_state"`
+	PlannedActions          types.List                     `tfsdk:"planned_actions"`
+	MaintenanceWindow       *common.MaintenanceWindowModel `tfsdk:"maintenance_window"`
+	MaintenanceOverride     types.Bool                     `tfsdk:"maintenance_override"`
 }
 
 type ClientACLsModel struct {
@@ -127,12 +137,13 @@ type ClientACLsModel struct {
 }
 
 type TopicModel struct {
-	CleanupPolicy   types.String `tfsdk:"cleanup_policy"`
-	Partitions      types.Int64  `tfsdk:"partitions"`
-	SegmentSizeMb   types.Int64  `tfsdk:"segment_size_mb"`
-	RetentionMs     types.Int64  `tfsdk:"retention_ms"`
-	RetentionBytes  types.Int64  `tfsdk:"retention_bytes"`
-	CompressionType types.String `tfsdk:"compression_type"`
+	CleanupPolicy      types.String `tfsdk:"cleanup_policy"`
+	Partitions         types.Int64  `tfsdk:"partitions"`
+	SegmentSizeMb      types.Int64  `tfsdk:"segment_size_mb"`
+	RetentionMs        types.Int64  `tfsdk:"retention_ms"`
+	RetentionBytes     types.Int64  `tfsdk:"retention_bytes"`
+	CompressionType    types.String `tfsdk:"compression_type"`
+	AllowTopicRecreate types.Bool   `tfsdk:"allow_topic_recreate"`
 }
 
 type QuotaModel struct {
@@ -155,12 +166,13 @@ func (m ClientACLsModel) AttributeTypes() map[string]attr.Type {
 
 func (m TopicModel) AttributeTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"cleanup_policy":   types.StringType,
-		"partitions":       types.Int64Type,
-		"segment_size_mb":  types.Int64Type,
-		"retention_ms":     types.Int64Type,
-		"retention_bytes":  types.Int64Type,
-		"compression_type": types.StringType,
+		"cleanup_policy":       types.StringType,
+		"partitions":           types.Int64Type,
+		"segment_size_mb":      types.Int64Type,
+		"retention_ms":         types.Int64Type,
+		"retention_bytes":      types.Int64Type,
+		"compression_type":     types.StringType,
+		"allow_topic_recreate": types.BoolType,
 	}
 }
 
@@ -376,15 +388,7 @@ func (r KafkaResource) Schema(
 				MarkdownDescription: "Список топиков.",
 				Optional:            true,
 				Validators: []validator.Map{
-					mapvalidator.KeysAre(
-						stringvalidator.LengthAtLeast(1),
-						stringvalidator.LengthAtMost(255),
-						stringvalidator.RegexMatches(
-							regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\.\-_]*$`),
-							"may contain uppercase/lowercase Latin letters, numbers, "+
-								"punctuation marks ['.' '-' '_']. (cannot start with punctuation)",
-						),
-					),
+					mapvalidator.KeysAre(topicNameValidators()...),
 					customvalidators.AtLeastOneOfIfValueAre(
 						"cleanup_policy",
 						"delete",
@@ -408,36 +412,25 @@ func (r KafkaResource) Schema(
 						"cleanup_policy": schema.StringAttribute{
 							Required:            true,
 							MarkdownDescription: "Политика очистки для данного топика.",
-							Validators: []validator.String{
-								stringvalidator.OneOf(
-									"delete",
-									"compact",
-									"delete,compact",
-								),
-							},
+							Validators:          topicCleanupPolicyValidators(),
 						},
 
 						"partitions": schema.Int64Attribute{
-							Required:            true,
-							MarkdownDescription: "Количество разделов в топике.",
+							Required: true,
+							MarkdownDescription: "Количество разделов в топике. Уменьшение количества разделов " +
+								"пересоздает топик и требует `allow_topic_recreate`.",
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
-								custommodifires.GrowOnly(),
-							},
-							Validators: []validator.Int64{
-								int64validator.AtLeast(1),
-								int64validator.AtMost(50),
 							},
+							Validators: topicPartitionsValidators(),
 						},
 
 						"segment_size_mb": schema.Int64Attribute{
 							Optional:            true,
 							Computed:            true,
-							Default:             int64default.StaticInt64(1024),
+							Default:             int64default.StaticInt64(topicDefaultSegmentSizeMb),
 							MarkdownDescription: "Максимальный размер для хранения данных в разделах указан в Мб.",
-							Validators: []validator.Int64{
-								int64validator.OneOf(1024, 512, 256, 128),
-							},
+							Validators:          topicSegmentSizeValidators(),
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
@@ -449,10 +442,7 @@ func (r KafkaResource) Schema(
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
-							Validators: []validator.Int64{
-								int64validator.AtLeast(1800000),
-								int64validator.AtMost(7776000000),
-							},
+							Validators: topicRetentionMsValidators(),
 						},
 
 						"retention_bytes": schema.Int64Attribute{
@@ -461,34 +451,54 @@ func (r KafkaResource) Schema(
 							PlanModifiers: []planmodifier.Int64{
 								int64planmodifier.UseStateForUnknown(),
 							},
-							Validators: []validator.Int64{
-								int64validator.AtLeast(134217728),
-								int64validator.AtMost(1000000000000),
-							},
+							Validators: topicRetentionBytesValidators(),
 						},
 						"compression_type": schema.StringAttribute{
 							Computed:            true,
 							Optional:            true,
-							Default:             stringdefault.StaticString("default"),
+							Default:             stringdefault.StaticString(topicDefaultCompressionType),
 							MarkdownDescription: "Тип сжатия",
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
-							Validators: []validator.String{
-								stringvalidator.OneOf(
-									"default",
-									"uncompressed",
-									"zstd",
-									"lz4",
-									"snappy",
-									"gzip",
-								),
-							},
+							Validators: topicCompressionTypeValidators(),
+						},
+						"allow_topic_recreate": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+							MarkdownDescription: "Разрешить удаление и создание топика заново для изменений, " +
+								"которые нельзя применить на месте (уменьшение `partitions`). " +
+								"Все данные топика будут потеряны.",
 						},
 					},
 				},
 			},
 
+			"topics_ownership": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("exclusive"),
+				MarkdownDescription: "Режим владения топиками. `exclusive` - кластер управляет всеми топиками и удаляет " +
+					"не описанные в `topics`. `shared` - кластер управляет только топиками из `topics`, " +
+					"остальные топики (например, ресурсы `vtb_kafka_topic`) игнорируются.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("exclusive", "shared"),
+				},
+			},
+
+			"acls_ownership": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("exclusive"),
+				MarkdownDescription: "Режим владения ACL. `exclusive` - кластер управляет ACL всех клиентов и удаляет " +
+					"не описанные в `acls`. `shared` - кластер управляет только ACL клиентов из `acls`, " +
+					"ACL остальных клиентов (например, ресурсы `vtb_kafka_acl`) игнорируются.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("exclusive", "shared"),
+				},
+			},
+
 			"acls": schema.MapNestedAttribute{
 				Optional:            true,
 				MarkdownDescription: "Список ACLS.",
@@ -522,15 +532,7 @@ func (r KafkaResource) Schema(
 								custommodifires.DefaultEmptyStringList(),
 							},
 							Validators: []validator.Set{
-								setvalidator.ValueStringsAre(
-									stringvalidator.LengthAtLeast(1),
-									stringvalidator.LengthAtMost(255),
-									stringvalidator.RegexMatches(
-										regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\.\-_]*$`),
-										"may contain uppercase/lowercase Latin letters, numbers, "+
-											"punctuation marks ['.' '-' '_']. (cannot start with punctuation)",
-									),
-								),
+								setvalidator.ValueStringsAre(topicNameValidators()...),
 							},
 						},
 
@@ -543,15 +545,7 @@ func (r KafkaResource) Schema(
 								custommodifires.DefaultEmptyStringList(),
 							},
 							Validators: []validator.Set{
-								setvalidator.ValueStringsAre(
-									stringvalidator.LengthAtLeast(1),
-									stringvalidator.LengthAtMost(255),
-									stringvalidator.RegexMatches(
-										regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\.\-_]*$`),
-										"may contain uppercase/lowercase Latin letters, numbers, "+
-											"punctuation marks ['.' '-' '_']. (cannot start with punctuation)",
-									),
-								),
+								setvalidator.ValueStringsAre(topicNameValidators()...),
 							},
 						},
 
@@ -689,6 +683,18 @@ func (r KafkaResource) Schema(
 				},
 			},
 
+			"quotas_ownership": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("exclusive"),
+				MarkdownDescription: "Режим владения квотами. `exclusive` - в `quotas` отображаются все квоты кластера. " +
+					"`shared` - кластер управляет только квотами из `quotas`, остальные квоты " +
+					"(например, ресурсы `vtb_kafka_quota`) игнорируются.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("exclusive", "shared"),
+				},
+			},
+
 			"financial_project": schema.StringAttribute{
 				Required:            true,
 				Description:         "Источник финансирования.",
@@ -714,6 +720,10 @@ func (r KafkaResource) Schema(
 				},
 				MarkdownDescription: "Connection URL",
 			},
+			"power_state":          common.PowerStateSchema,
+			"planned_actions":      common.PlannedActionsSchema,
+			"maintenance_window":   common.MaintenanceWindowSchema,
+			"maintenance_override": common.MaintenanceOverrideSchema,
 		},
 	}
 }
@@ -723,7 +733,7 @@ func (r KafkaResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(ctx, r.client, path.Root("order_id"), kafkaItemKinds, req, resp)
 }
 
 func (r KafkaResource) ModifyPlan(
@@ -738,6 +748,9 @@ func (r KafkaResource) ModifyPlan(
 	var plan, state KafkaClusterResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -762,10 +775,35 @@ func (r KafkaResource) ModifyPlan(
 		r.validateQuotas(ctx, &plan, &resp.Diagnostics)
 	}
 
+	if !req.State.Raw.IsNull() && !plan.Topics.IsUnknown() && !state.Topics.IsNull() {
+		planTopics := make(map[string]TopicModel)
+		plan.Topics.ElementsAs(ctx, &planTopics, false)
+		stateTopics := make(map[string]TopicModel)
+		state.Topics.ElementsAs(ctx, &stateTopics, false)
+		for topicName, planTopic := range planTopics {
+			if stateTopic, ok := stateTopics[topicName]; ok {
+				validateTopicChange(
+					topicName, stateTopic, planTopic,
+					path.Root("topics").AtMapKey(topicName), &resp.Diagnostics,
+				)
+			}
+		}
+	}
+
+	if !req.State.Raw.IsNull() {
+		validateChangesWhilePoweredOff(&state, &plan, &resp.Diagnostics)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r KafkaResource) Create(
@@ -846,14 +884,14 @@ func (r KafkaResource) Create(
 	if !plan.Topics.IsNull() || !plan.Topics.IsUnknown() {
 		pTopics := make(map[string]TopicModel)
 		plan.Topics.ElementsAs(ctx, &pTopics, false)
-		diags := applyTopics(pTopics, order)
+		diags := applyTopics(pTopics, order, managedNames[TopicModel](plan.TopicsOwnership, nil))
 		resp.Diagnostics.Append(diags...)
 	}
 
 	if !plan.ACLs.IsNull() || !plan.ACLs.IsUnknown() {
 		planAcls := make(map[string]ClientACLsModel)
 		plan.ACLs.ElementsAs(ctx, &planAcls, false)
-		diags := applyACLs(ctx, planAcls, order)
+		diags := applyACLs(ctx, planAcls, order, managedNames[ClientACLsModel](plan.ACLsOwnership, nil))
 		resp.Diagnostics.Append(diags...)
 	}
 
@@ -861,11 +899,25 @@ func (r KafkaResource) Create(
 		return
 	}
 
+	if plan.PowerState.ValueString() == "off" {
+		diags := utils.ChangePowerState(order, plan.PowerState.ValueString(), consts.CREATE_RES_FAIL)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
-	plan.KafkaVersion = types.StringValue(orderItem.Data.Config.(entities.KafkaItemConfig).KafkaVersion)
+	config, err := entities.ItemConfig[entities.KafkaItemConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	plan.KafkaVersion = types.StringValue(config.KafkaVersion)
 	plan.BuildVersion = types.StringValue(orderItem.Data.Build.SetupVersion)
-	plan.ConnectionURL = types.StringValue(orderItem.Data.Config.(entities.KafkaItemConfig).ConnectionURL)
+	plan.ConnectionURL = types.StringValue(config.ConnectionURL)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -997,11 +1049,24 @@ func (r KafkaResource) Read(
 		}
 	}
 
+	var quotasOwnership types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("quotas_ownership"), &quotasOwnership)...)
+	if quotasOwnership.IsNull() {
+		quotasOwnership = types.StringValue("exclusive")
+	}
+	var prevQuotas []QuotaModel
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("quotas"), &prevQuotas)...)
+	prevQuotasMap := make(map[string]QuotaModel)
+	for _, q := range prevQuotas {
+		prevQuotasMap[q.key()] = q
+	}
+
 	state := KafkaClusterResourceModel{
 		OrderID:          orderID,
 		LayoutID:         types.StringValue(layoutId),
-		ACLs:             readACLS(kafkaConfig, ctx, resp),
-		Quotas:           readQuotas(ctx, kafkaConfig),
+		ACLs:             readACLS(kafkaConfig, ctx, req, resp),
+		Quotas:           readQuotas(ctx, kafkaConfig, managedNames(quotasOwnership, prevQuotasMap)),
+		QuotasOwnership:  quotasOwnership,
 		Access:           utils.ReadAccessMapV2(vmItem.Data.ACLs),
 		ItemID:           types.StringValue(kafkaItem.ID),
 		Label:            types.StringValue(order.Label),
@@ -1039,12 +1104,37 @@ func (r KafkaResource) Read(
 		FinancialProject:        types.StringValue(order.FinancialSource.Name),
 		UpgradeKafkaDistribMode: types.StringValue("none"),
 		ConnectionURL:           types.StringValue(kafkaConfig.ConnectionURL),
+		PlannedActions:          utils.PriorPlannedActions(ctx, req.State),
+		PowerState:              utils.ReadPowerState(ctx, kafkaItem.Data.State, req.State),
 	}
 
 	// Get Topics
-	orderTopics := kafkaConfig.Topics
+	var topicsOwnership types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("topics_ownership"), &topicsOwnership)...)
+	if topicsOwnership.IsNull() {
+		topicsOwnership = types.StringValue("exclusive")
+	}
+	state.TopicsOwnership = topicsOwnership
+
+	var aclsOwnership types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("acls_ownership"), &aclsOwnership)...)
+	if aclsOwnership.IsNull() {
+		aclsOwnership = types.StringValue("exclusive")
+	}
+	state.ACLsOwnership = aclsOwnership
+
+	stateTopics := make(map[string]TopicModel)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("topics"), &stateTopics)...)
+
+	orderTopics := filterManagedTopics(kafkaConfig.Topics, managedNames(topicsOwnership, stateTopics))
 	topics, diags := convertTopicsToTerraform(orderTopics)
 	resp.Diagnostics.Append(diags...)
+	for topicName, topic := range topics {
+		if stateTopic, ok := stateTopics[topicName]; ok && !stateTopic.AllowTopicRecreate.IsNull() {
+			topic.AllowTopicRecreate = stateTopic.AllowTopicRecreate
+			topics[topicName] = topic
+		}
+	}
 
 	var tfTopics basetypes.MapValue
 	if len(topics) > 0 {
@@ -1062,6 +1152,9 @@ func (r KafkaResource) Read(
 		return
 	}
 
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_window"), &state.MaintenanceWindow)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_override"), &state.MaintenanceOverride)...)
+
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
 	if !lifetime.IsNull() {
@@ -1080,6 +1173,20 @@ func (r KafkaResource) Update(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetKafkaOrder(
 		r.client.Creds,
@@ -1126,6 +1233,16 @@ func (r KafkaResource) Update(
 	labelChanged := plan.Label != state.Label
 	mountChanged := utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts)
 	flavorChanged := plan.Flavor != state.Flavor
+
+	// кластер запускается до остальных изменений, а останавливается после них
+	powerStateChanged := !plan.PowerState.Equal(state.PowerState)
+	if powerStateChanged && plan.PowerState.ValueString() == "on" {
+		resp.Diagnostics.Append(utils.ChangePowerState(order, "on", consts.UPDATE_RES_FAIL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	if labelChanged {
 		utils.ChangeOrderLabel(order, plan.Label.ValueString(), resp)
 	}
@@ -1137,8 +1254,10 @@ func (r KafkaResource) Update(
 	if !plan.Topics.Equal(state.Topics) {
 		pTopics := make(map[string]TopicModel)
 		plan.Topics.ElementsAs(ctx, &pTopics, false)
+		sTopics := make(map[string]TopicModel)
+		state.Topics.ElementsAs(ctx, &sTopics, false)
 
-		updateTopics(order, pTopics, resp)
+		updateTopics(order, pTopics, managedNames(plan.TopicsOwnership, sTopics), resp)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -1148,7 +1267,10 @@ func (r KafkaResource) Update(
 		planAcls := make(map[string]ClientACLsModel)
 		plan.ACLs.ElementsAs(ctx, &planAcls, false)
 
-		updateACLs(ctx, order, planAcls, resp)
+		stateAcls := make(map[string]ClientACLsModel)
+		state.ACLs.ElementsAs(ctx, &stateAcls, false)
+
+		updateACLs(ctx, order, planAcls, managedNames(plan.ACLsOwnership, stateAcls), resp)
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -1207,6 +1329,13 @@ func (r KafkaResource) Update(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if powerStateChanged && plan.PowerState.ValueString() == "off" {
+		resp.Diagnostics.Append(utils.ChangePowerState(order, "off", consts.UPDATE_RES_FAIL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -1292,16 +1421,7 @@ func (r KafkaResource) validateQuotas(
 	plan *KafkaClusterResourceModel,
 	diags *diag.Diagnostics,
 ) {
-	if plan.KafkaVersion.Equal(types.StringValue("2.13-2.4.1")) {
-		diags.AddAttributeError(
-			path.Root("quotas"),
-			consts.MODIFY_PLAN_FAIL,
-			fmt.Sprintf(
-				"quotas is only can used with kafka versions `2.13-2.8.2` or `2.13-3.6.2`, but choosen version is `%s`",
-				plan.KafkaVersion.ValueString(),
-			),
-		)
-	}
+	validateQuotasKafkaVersion(plan.KafkaVersion.ValueString(), path.Root("quotas"), diags)
 
 	var quotas []QuotaModel
 	diags.Append(plan.Quotas.ElementsAs(ctx, &quotas, false)...)
@@ -1323,21 +1443,7 @@ func (r KafkaResource) validateQuotas(
 		}
 		uniqueClientCNs = append(uniqueClientCNs, quota.ClientCN.ValueString())
 
-		if quota.Type.ValueString() == "personal" && quota.ClientCN.IsNull() {
-			diags.AddAttributeError(
-				path.Root("quotas"),
-				consts.MODIFY_PLAN_FAIL,
-				"`client_cn` must be specified for `personal` quotas.",
-			)
-		}
-
-		if quota.Type.ValueString() == "default" && !quota.ClientCN.IsNull() {
-			diags.AddAttributeError(
-				path.Root("quotas"),
-				consts.MODIFY_PLAN_FAIL,
-				"`client_cn` must not be specified for `default` quotas.",
-			)
-		}
+		validateQuotaClientCN(quota, path.Root("quotas"), diags)
 
 		if quota.Type.ValueString() == "default" {
 			defaultQuotas = defaultQuotas + 1
@@ -1353,6 +1459,37 @@ func (r KafkaResource) validateQuotas(
 	}
 }
 
+func validateQuotasKafkaVersion(kafkaVersion string, attrPath path.Path, diags *diag.Diagnostics) {
+	if kafkaVersion == "2.13-2.4.1" {
+		diags.AddAttributeError(
+			attrPath,
+			consts.MODIFY_PLAN_FAIL,
+			fmt.Sprintf(
+				"quotas is only can used with kafka versions `2.13-2.8.2` or `2.13-3.6.2`, but choosen version is `%s`",
+				kafkaVersion,
+			),
+		)
+	}
+}
+
+func validateQuotaClientCN(quota QuotaModel, attrPath path.Path, diags *diag.Diagnostics) {
+	if quota.Type.ValueString() == "personal" && quota.ClientCN.IsNull() {
+		diags.AddAttributeError(
+			attrPath,
+			consts.MODIFY_PLAN_FAIL,
+			"`client_cn` must be specified for `personal` quotas.",
+		)
+	}
+
+	if quota.Type.ValueString() == "default" && !quota.ClientCN.IsNull() {
+		diags.AddAttributeError(
+			attrPath,
+			consts.MODIFY_PLAN_FAIL,
+			"`client_cn` must not be specified for `default` quotas.",
+		)
+	}
+}
+
 func changeKafkaFlavor(
 	order *orders.Kafka,
 	plan *KafkaClusterResourceModel,
@@ -1396,7 +1533,34 @@ func changeKafkaExtraMounts(
 }
 
 // #topics
-func applyTopics(pTopics map[string]TopicModel, order *orders.Kafka) (diags diag.Diagnostics) {
+
+// managedNames определяет топики (клиентов ACL) заказа, которыми управляет кластер.
+// В режиме shared это только имена из прошлой конфигурации кластера
+func managedNames[T any](ownership types.String, stateItems map[string]T) func(name string) bool {
+	if ownership.ValueString() != "shared" {
+		return func(string) bool { return true }
+	}
+	return func(name string) bool {
+		_, ok := stateItems[name]
+		return ok
+	}
+}
+
+func filterManagedTopics(topics []entities.KafkaTopic, managed func(topicName string) bool) []entities.KafkaTopic {
+	var filtered []entities.KafkaTopic
+	for _, topic := range topics {
+		if managed(topic.TopicName) {
+			filtered = append(filtered, topic)
+		}
+	}
+	return filtered
+}
+
+func applyTopics(
+	pTopics map[string]TopicModel,
+	order *orders.Kafka,
+	managed func(topicName string) bool,
+) (diags diag.Diagnostics) {
 
 	err := order.Sync()
 	if err != nil {
@@ -1413,6 +1577,10 @@ func applyTopics(pTopics map[string]TopicModel, order *orders.Kafka) (diags diag
 			fmt.Sprintf("Get `topics` from portal ended with error.\nError: %s", err.Error()),
 		)
 	}
+	orderTopics = filterManagedTopics(orderTopics, func(topicName string) bool {
+		_, inPlan := pTopics[topicName]
+		return inPlan || managed(topicName)
+	})
 
 	var temporaryTopics map[string]TopicModel = pTopics
 	planTopics, xdiags := converTerraformTopicsToEntities(temporaryTopics)
@@ -1421,6 +1589,7 @@ func applyTopics(pTopics map[string]TopicModel, order *orders.Kafka) (diags diag
 	var toCreate []entities.KafkaTopic
 	var toRemove []entities.KafkaTopic
 	var toEdit []entities.KafkaTopic
+	var toRecreate []entities.KafkaTopic
 	var existsTopics []bool = make([]bool, len(orderTopics))
 
 	for _, planTopic := range planTopics {
@@ -1434,6 +1603,11 @@ func applyTopics(pTopics map[string]TopicModel, order *orders.Kafka) (diags diag
 					existsTopics[i] = true
 					exists = true
 					break
+				} else if classifyTopicChange(existTopic, planTopic).Kind == topicChangeDestructive &&
+					pTopics[planTopic.TopicName].AllowTopicRecreate.ValueBool() {
+					toRecreate = append(toRecreate, planTopic)
+					existsTopics[i] = true
+					exists = true
 				} else {
 					toEdit = append(toEdit, planTopic)
 					existsTopics[i] = true
@@ -1479,6 +1653,10 @@ func applyTopics(pTopics map[string]TopicModel, order *orders.Kafka) (diags diag
 		}
 	}
 
+	if len(toRecreate) > 0 {
+		diags.Append(recreateTopics(order, toRecreate)...)
+	}
+
 	if len(toEdit) > 0 {
 		err = order.EditTopics(toEdit, false)
 		if err != nil {
@@ -1494,9 +1672,31 @@ func applyTopics(pTopics map[string]TopicModel, order *orders.Kafka) (diags diag
 	return
 }
 
+// recreateTopics удаляет топики и создает их заново с новыми параметрами
+func recreateTopics(order *orders.Kafka, topics []entities.KafkaTopic) (diags diag.Diagnostics) {
+	err := order.DeleteTopics(topics, false)
+	if err != nil {
+		diags.AddWarning(
+			"Recreate topics",
+			fmt.Sprintf("Kafka didn't deleted topics for recreation.\nError: %s", err.Error()),
+		)
+		return diags
+	}
+
+	err = order.CreateTopics(topics, false)
+	if err != nil {
+		diags.AddWarning(
+			"Recreate topics",
+			fmt.Sprintf("Kafka didn't created topics after deletion.\nError: %s", err.Error()),
+		)
+	}
+	return diags
+}
+
 func updateTopics(
 	order *orders.Kafka,
 	pTopics map[string]TopicModel,
+	managed func(topicName string) bool,
 	resp *resource.UpdateResponse,
 ) {
 	var tmpTopics map[string]TopicModel = make(map[string]TopicModel)
@@ -1517,8 +1717,13 @@ func updateTopics(
 		return
 	}
 
+	orderTopics = filterManagedTopics(orderTopics, func(topicName string) bool {
+		_, inPlan := pTopics[topicName]
+		return inPlan || managed(topicName)
+	})
+
 	if isTopicsChanged(planTopics, orderTopics) {
-		diags = applyTopics(pTopics, order)
+		diags = applyTopics(pTopics, order, managed)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -1535,10 +1740,11 @@ func convertTopicsToTerraform(entityTopics []entities.KafkaTopic) (
 	for _, topic := range entityTopics {
 
 		pointerTopics[topic.TopicName] = &TopicModel{
-			CleanupPolicy:   types.StringValue(topic.CleanupPolicy),
-			Partitions:      types.Int64Value(topic.PartitionsNumber),
-			SegmentSizeMb:   types.Int64Value(bytesToMb(topic.SegmentSize)),
-			CompressionType: types.StringValue(topic.CompressionType),
+			CleanupPolicy:      types.StringValue(topic.CleanupPolicy),
+			Partitions:         types.Int64Value(topic.PartitionsNumber),
+			SegmentSizeMb:      types.Int64Value(bytesToMb(topic.SegmentSize)),
+			CompressionType:    types.StringValue(topic.CompressionType),
+			AllowTopicRecreate: types.BoolValue(false),
 		}
 		topicModel := pointerTopics[topic.TopicName]
 
@@ -1569,15 +1775,7 @@ func converTerraformTopicsToEntities(
 	diags diag.Diagnostics,
 ) {
 	for topicName, topicData := range terraformTopics {
-		entityTopics = append(entityTopics, entities.KafkaTopic{
-			TopicName:        topicName,
-			CleanupPolicy:    topicData.CleanupPolicy.ValueString(),
-			PartitionsNumber: topicData.Partitions.ValueInt64(),
-			RetentionMs:      topicData.RetentionMs.ValueInt64(),
-			RetentionBytes:   topicData.RetentionBytes.ValueInt64(),
-			SegmentSize:      mbToBytes(topicData.SegmentSizeMb.ValueInt64()),
-			CompressionType:  topicData.CompressionType.ValueString(),
-		})
+		entityTopics = append(entityTopics, topicModelToEntity(topicName, topicData))
 	}
 	return
 }
@@ -1587,6 +1785,7 @@ func applyACLs(
 	ctx context.Context,
 	planAcls map[string]ClientACLsModel,
 	order *orders.Kafka,
+	managed func(clientCN string) bool,
 ) (diags diag.Diagnostics) {
 
 	err := order.Sync()
@@ -1616,6 +1815,14 @@ func applyACLs(
 		return diags
 	}
 
+	managedOrPlanned := func(clientCN string) bool {
+		_, inPlan := planAcls[clientCN]
+		return inPlan || managed(clientCN)
+	}
+	orderAccessACLs, orderTransactionalACLs, orderIdempotentACLs = filterManagedACLs(
+		managedOrPlanned, orderAccessACLs, orderTransactionalACLs, orderIdempotentACLs,
+	)
+
 	var temporaryACLs map[string]ClientACLsModel = planAcls
 	planAccessACLs, planTransactionalACLs, planIdempotentACLs, xdiags := convertTerraformACLsToEntities(ctx, temporaryACLs)
 	diags.Append(xdiags...)
@@ -1848,6 +2055,7 @@ func updateACLs(
 	ctx context.Context,
 	order *orders.Kafka,
 	planAcls map[string]ClientACLsModel,
+	managed func(clientCN string) bool,
 	resp *resource.UpdateResponse,
 ) {
 	var tmpClientACLs map[string]ClientACLsModel = make(map[string]ClientACLsModel)
@@ -1886,14 +2094,53 @@ func updateACLs(
 		return
 	}
 
+	orderAccessACLs, orderTransactionalACLs, orderIdempotentACLs = filterManagedACLs(
+		func(clientCN string) bool {
+			_, inPlan := planAcls[clientCN]
+			return inPlan || managed(clientCN)
+		},
+		orderAccessACLs, orderTransactionalACLs, orderIdempotentACLs,
+	)
+
 	if isAccessACLsChagned(accessACLs, orderAccessACLs) ||
 		isTransactionalACLsChanged(transactionalACLs, orderTransactionalACLs) ||
 		isIdempotentACLsChanged(idempotentACLs, orderIdempotentACLs) {
-		diags = applyACLs(ctx, planAcls, order)
+		diags = applyACLs(ctx, planAcls, order, managed)
 		resp.Diagnostics.Append(diags...)
 	}
 }
 
+func filterManagedACLs(
+	managed func(clientCN string) bool,
+	accessACLs []entities.KafkaACL,
+	transactionalACLs []entities.KafkaTransactionalACL,
+	idempotentACLs []entities.KafkaIdempotentACL,
+) (
+	[]entities.KafkaACL,
+	[]entities.KafkaTransactionalACL,
+	[]entities.KafkaIdempotentACL,
+) {
+	var filteredAccess []entities.KafkaACL
+	for _, acl := range accessACLs {
+		if managed(acl.ClientCN) {
+			filteredAccess = append(filteredAccess, acl)
+		}
+	}
+	var filteredTransactional []entities.KafkaTransactionalACL
+	for _, acl := range transactionalACLs {
+		if managed(acl.ClientCN) {
+			filteredTransactional = append(filteredTransactional, acl)
+		}
+	}
+	var filteredIdempotent []entities.KafkaIdempotentACL
+	for _, acl := range idempotentACLs {
+		if managed(acl.ClientCN) {
+			filteredIdempotent = append(filteredIdempotent, acl)
+		}
+	}
+	return filteredAccess, filteredTransactional, filteredIdempotent
+}
+
 func convertEntitiesACLsToTerraform(
 	ctx context.Context,
 	accessACLs []entities.KafkaACL,
@@ -2205,18 +2452,17 @@ func deleteQuotas(
 	}
 }
 
-func readQuotas(ctx context.Context, kafkaConfig entities.KafkaItemConfig) basetypes.SetValue {
+func readQuotas(
+	ctx context.Context,
+	kafkaConfig entities.KafkaItemConfig,
+	managed func(quotaKey string) bool,
+) basetypes.SetValue {
 
 	var actualQuotas []QuotaModel
 	for _, quota := range kafkaConfig.Quotas {
-
-		q := QuotaModel{ProducerByteRate: types.Int64Value(quota.ProducerByteRate)}
-
-		if quota.ClientCN == "<default>" {
-			q.Type = types.StringValue("default")
-		} else {
-			q.Type = types.StringValue("personal")
-			q.ClientCN = types.StringValue(quota.ClientCN)
+		q := convertQuotaToTerraform(quota)
+		if !managed(q.key()) {
+			continue
 		}
 		actualQuotas = append(actualQuotas, q)
 	}
@@ -2229,15 +2475,44 @@ func readQuotas(ctx context.Context, kafkaConfig entities.KafkaItemConfig) baset
 	return quotas
 }
 
+func convertQuotaToTerraform(quota entities.KafkaQuota) QuotaModel {
+	q := QuotaModel{ProducerByteRate: types.Int64Value(quota.ProducerByteRate)}
+
+	if quota.ClientCN == "<default>" {
+		q.Type = types.StringValue("default")
+	} else {
+		q.Type = types.StringValue("personal")
+		q.ClientCN = types.StringValue(quota.ClientCN)
+	}
+	return q
+}
+
+// key ключ квоты: client_cn для персональных квот и "default" для квоты по умолчанию
+func (m QuotaModel) key() string {
+	if m.Type.ValueString() == "default" {
+		return "default"
+	}
+	return m.ClientCN.ValueString()
+}
+
 func readACLS(
 	kafkaConfig entities.KafkaItemConfig,
 	ctx context.Context,
+	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) basetypes.MapValue {
 
-	accessACLs := kafkaConfig.ACLs
-	transactionlACLs := kafkaConfig.TransactionalACLs
-	idempotentACLs := kafkaConfig.IdempotentACLs
+	var aclsOwnership types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("acls_ownership"), &aclsOwnership)...)
+	stateACLs := make(map[string]ClientACLsModel)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("acls"), &stateACLs)...)
+
+	accessACLs, transactionlACLs, idempotentACLs := filterManagedACLs(
+		managedNames(aclsOwnership, stateACLs),
+		kafkaConfig.ACLs,
+		kafkaConfig.TransactionalACLs,
+		kafkaConfig.IdempotentACLs,
+	)
 
 	portalACLs, diags := convertEntitiesACLsToTerraform(ctx, accessACLs, transactionlACLs, idempotentACLs)
 	resp.Diagnostics.Append(diags...)
@@ -2300,6 +2575,95 @@ func upgradeKafkaVersion(
 	return diag
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *KafkaClusterResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+	powerStateChanged := !plan.PowerState.Equal(state.PowerState)
+
+	if powerStateChanged && plan.PowerState.ValueString() == "on" {
+		actions.Add("start_kafka", "Запуск кластера")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.Topics.Equal(state.Topics) {
+		actions.Add("kafka_topics", "Создание, изменение и удаление топиков")
+	}
+
+	if !plan.ACLs.Equal(state.ACLs) {
+		actions.Add("kafka_acls", "Создание и удаление ACL")
+	}
+
+	if !plan.Quotas.Equal(state.Quotas) {
+		actions.Add("kafka_quotas", "Создание и удаление квот")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("kafka_expand_mount_point", "Расширение точки монтирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("resize_kafka_cluster_vms", "Изменение конфигурации ВМ кластера с перезапуском брокеров")
+	}
+
+	if !plan.KafkaVersion.Equal(state.KafkaVersion) {
+		actions.AddDisruptive("kafka_release_upgrade_new_version", "Обновление версии Kafka с перезапуском кластера")
+	}
+
+	if !plan.LayoutID.Equal(state.LayoutID) {
+		actions.AddDisruptive("kafka_release_add_brokers", "Добавление брокеров с перезапуском кластера")
+	}
+
+	if !plan.UpgradeKafkaDistribMode.IsNull() && plan.UpgradeKafkaDistribMode.ValueString() == "latest" {
+		actions.AddDisruptive("kafka_release_upgrade_version", "Обновление версии дистрибутива с перезапуском кластера")
+	}
+
+	if !plan.ClusterName.Equal(state.ClusterName) {
+		actions.Add("kafka_edit_cluster_name", "Изменение имени кластера")
+	}
+
+	if powerStateChanged && plan.PowerState.ValueString() == "off" {
+		actions.AddDisruptive("stop_kafka", "Остановка кластера")
+	}
+	return actions
+}
+
+// validateChangesWhilePoweredOff запрещает изменения, которые портал не может применить
+// к остановленному кластеру. Если кластер запускается в том же плане, изменения допустимы
+func validateChangesWhilePoweredOff(state, plan *KafkaClusterResourceModel, diags *diag.Diagnostics) {
+	if state.PowerState.ValueString() != "off" || plan.PowerState.ValueString() != "off" {
+		return
+	}
+
+	addErr := func(attrPath path.Path, what string) {
+		diags.AddAttributeError(
+			attrPath,
+			consts.MODIFY_PLAN_FAIL,
+			fmt.Sprintf(
+				"Kafka cluster is stopped, %s can't be changed. "+
+					"Set `power_state = \"on\"` to start the cluster before changing it.",
+				what,
+			),
+		)
+	}
+
+	if !plan.Topics.IsUnknown() && !plan.Topics.Equal(state.Topics) {
+		addErr(path.Root("topics"), "topics")
+	}
+	if !plan.ACLs.IsUnknown() && !plan.ACLs.Equal(state.ACLs) {
+		addErr(path.Root("acls"), "ACLs")
+	}
+	if plan.Flavor != state.Flavor {
+		addErr(path.Root("flavor"), "flavor")
+	}
+}
+
 // Горизонтальное масштабирование
 func (r KafkaResource) horizontalScaling(
 	order *orders.Kafka,
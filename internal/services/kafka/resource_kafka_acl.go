@@ -0,0 +1,432 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/internal/custommodifires"
+	"terraform-provider-vtb/internal/utils"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &KafkaACLResource{}
+	_ resource.ResourceWithImportState = &KafkaACLResource{}
+)
+
+type KafkaACLResource struct {
+	client *client.CloudClient
+}
+
+func NewKafkaACLResource() resource.Resource {
+	return &KafkaACLResource{}
+}
+
+func (r KafkaACLResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_acl"
+}
+
+func (r *KafkaACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type KafkaACLResourceModel struct {
+	KafkaOrderID        types.String `tfsdk:"kafka_order_id"`
+	ClientCN            types.String `tfsdk:"client_cn"`
+	Idempotent          types.Bool   `tfsdk:"allow_idempotent"`
+	ConsumerByName      types.Set    `tfsdk:"consumer_by_name"`
+	ProducerByName      types.Set    `tfsdk:"producer_by_name"`
+	ConsumerByMask      types.Set    `tfsdk:"consumer_by_mask"`
+	ProducerByMask      types.Set    `tfsdk:"producer_by_mask"`
+	TransactionalByName types.Set    `tfsdk:"transactional_by_name"`
+	TransactionalByMask types.Set    `tfsdk:"transactional_by_mask"`
+}
+
+func (m KafkaACLResourceModel) clientACLs() map[string]ClientACLsModel {
+	return map[string]ClientACLsModel{
+		m.ClientCN.ValueString(): {
+			Idempotent:          m.Idempotent,
+			ConsumerByName:      m.ConsumerByName,
+			ProducerByName:      m.ProducerByName,
+			ConsumerByMask:      m.ConsumerByMask,
+			ProducerByMask:      m.ProducerByMask,
+			TransactionalByName: m.TransactionalByName,
+			TransactionalByMask: m.TransactionalByMask,
+		},
+	}
+}
+
+// ownClient ограничивает изменения ACL кластера клиентом ресурса
+func (m KafkaACLResourceModel) ownClient() func(clientCN string) bool {
+	return func(clientCN string) bool {
+		return clientCN == m.ClientCN.ValueString()
+	}
+}
+
+func (r KafkaACLResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	topicNamesValidator := setvalidator.ValueStringsAre(topicNameValidators()...)
+	masksValidator := setvalidator.ValueStringsAre(
+		stringvalidator.LengthAtLeast(1),
+		stringvalidator.LengthAtMost(255),
+		stringvalidator.RegexMatches(
+			regexp.MustCompile(`^[a-zA-Z0-9-_\.]+$`),
+			"may contain uppercase/lowercase Latin letters, numbers, "+
+				"punctuation marks ['.' '-' '_'].",
+		),
+	)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "ACL одного клиента (client_cn) кластера Kafka. Ресурс управляет только ACL " +
+			"своего клиента, ACL остальных клиентов не затрагиваются. Чтобы кластер не удалял такие ACL, " +
+			"укажите у него `acls_ownership = \"shared\"`.",
+		Attributes: map[string]schema.Attribute{
+			"kafka_order_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Идентификатор заказа кластера Kafka.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"client_cn": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "CN сертификата клиента.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9_\-@\.]+$`),
+						"may contain uppercase/lowercase Latin letters, numbers, "+
+							"punctuation marks ['.' '-' '_'] and '@'",
+					),
+				},
+			},
+			"allow_idempotent": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Добавленный для этого client_cn ACL поддерживает идемпотентные действия.",
+			},
+			"consumer_by_name": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Список названий топиков, доступные клиенту для чтения/описания.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					custommodifires.DefaultEmptyStringList(),
+				},
+				Validators: []validator.Set{topicNamesValidator},
+			},
+			"producer_by_name": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Список названий топиков, доступные клиенту для записи/создания/описания.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					custommodifires.DefaultEmptyStringList(),
+				},
+				Validators: []validator.Set{topicNamesValidator},
+			},
+			"consumer_by_mask": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Список масок, указанных в форме префикса, доступные клиенту для чтения/описания.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					custommodifires.DefaultEmptyStringList(),
+				},
+				Validators: []validator.Set{masksValidator},
+			},
+			"producer_by_mask": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Список масок, указанных в префиксной форме, доступные клиенту записывать/создавать/описывать",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					custommodifires.DefaultEmptyStringList(),
+				},
+				Validators: []validator.Set{masksValidator},
+			},
+			"transactional_by_name": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Список имен транзакций, разрешающих клиенту использовать его",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					custommodifires.DefaultEmptyStringList(),
+				},
+				Validators: []validator.Set{masksValidator},
+			},
+			"transactional_by_mask": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Список транзакционных масок, разрешающих клиенту использовать его.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					custommodifires.DefaultEmptyStringList(),
+				},
+				Validators: []validator.Set{masksValidator},
+			},
+		},
+	}
+}
+
+func (r KafkaACLResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan KafkaACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	existsACLs, diags := readClientACLs(ctx, order, plan.ClientCN.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if existsACLs != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cn"),
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Client '%s' already has ACLs in kafka order '%s'. Import them with `tofu import` instead",
+				plan.ClientCN.ValueString(), plan.KafkaOrderID.ValueString(),
+			),
+		)
+		return
+	}
+
+	diags = applyACLs(ctx, plan.clientACLs(), order, plan.ownClient())
+	resp.Diagnostics.Append(warningsAsErrors(consts.CREATE_RES_FAIL, diags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r KafkaACLResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state KafkaACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, state.KafkaOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				state.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	clientACL, diags := readClientACLs(ctx, order, state.ClientCN.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if clientACL == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Idempotent = types.BoolValue(clientACL.Idempotent.ValueBool())
+	state.ConsumerByName = clientACL.ConsumerByName
+	state.ProducerByName = clientACL.ProducerByName
+	state.ConsumerByMask = clientACL.ConsumerByMask
+	state.ProducerByMask = clientACL.ProducerByMask
+	state.TransactionalByName = clientACL.TransactionalByName
+	state.TransactionalByMask = clientACL.TransactionalByMask
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r KafkaACLResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan KafkaACLResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	diags := applyACLs(ctx, plan.clientACLs(), order, plan.ownClient())
+	resp.Diagnostics.Append(warningsAsErrors(consts.UPDATE_RES_FAIL, diags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r KafkaACLResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state KafkaACLResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, state.KafkaOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				state.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	diags := applyACLs(ctx, map[string]ClientACLsModel{}, order, state.ownClient())
+	resp.Diagnostics.Append(warningsAsErrors(consts.DELETE_RES_FAIL, diags)...)
+}
+
+// ImportState принимает идентификатор в формате <order_id>/<client_cn>
+func (r KafkaACLResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	clientCN, ok := utils.ImportCompositeState(
+		ctx, r.client, path.Root("kafka_order_id"), kafkaItemKinds, "<order_id>/<client_cn>", req, resp,
+	)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_cn"), clientCN)...)
+}
+
+// readClientACLs возвращает ACL клиента из заказа или nil, если у клиента нет ACL
+func readClientACLs(ctx context.Context, order *orders.Kafka, clientCN string) (*ClientACLsModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	accessACLs, err := order.GetACLs()
+	if err != nil {
+		diags.AddError("Get Kafka access ACLs", err.Error())
+		return nil, diags
+	}
+	transactionalACLs, err := order.GetTransactionalACLs()
+	if err != nil {
+		diags.AddError("Get Kafka transactional ACLs", err.Error())
+		return nil, diags
+	}
+	idempotentACLs, err := order.GetIdempotentACLs()
+	if err != nil {
+		diags.AddError("Get Kafka idempotent ACLs", err.Error())
+		return nil, diags
+	}
+
+	accessACLs, transactionalACLs, idempotentACLs = filterManagedACLs(
+		func(cn string) bool { return cn == clientCN },
+		accessACLs, transactionalACLs, idempotentACLs,
+	)
+
+	clientACLs, convertDiags := convertEntitiesACLsToTerraform(ctx, accessACLs, transactionalACLs, idempotentACLs)
+	diags.Append(convertDiags...)
+	clientACL, ok := clientACLs[clientCN]
+	if !ok {
+		return nil, diags
+	}
+	return &clientACL, diags
+}
+
+// warningsAsErrors превращает предупреждения групповых операций (ACL, пересоздание топиков)
+// в ошибки: для отдельного ресурса неприменившиеся изменения не должны попасть в state
+func warningsAsErrors(summary string, diags diag.Diagnostics) (result diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity() == diag.SeverityWarning {
+			result.AddError(summary, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+			continue
+		}
+		result.Append(d)
+	}
+	return result
+}
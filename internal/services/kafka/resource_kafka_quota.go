@@ -0,0 +1,403 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/internal/utils"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &KafkaQuotaResource{}
+	_ resource.ResourceWithImportState    = &KafkaQuotaResource{}
+	_ resource.ResourceWithValidateConfig = &KafkaQuotaResource{}
+	_ resource.ResourceWithModifyPlan     = &KafkaQuotaResource{}
+)
+
+type KafkaQuotaResource struct {
+	client *client.CloudClient
+}
+
+func NewKafkaQuotaResource() resource.Resource {
+	return &KafkaQuotaResource{}
+}
+
+func (r KafkaQuotaResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_quota"
+}
+
+func (r *KafkaQuotaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type KafkaQuotaResourceModel struct {
+	KafkaOrderID     types.String `tfsdk:"kafka_order_id"`
+	Type             types.String `tfsdk:"type"`
+	ClientCN         types.String `tfsdk:"client_cn"`
+	ProducerByteRate types.Int64  `tfsdk:"producer_byte_rate"`
+}
+
+func (m KafkaQuotaResourceModel) quota() QuotaModel {
+	return QuotaModel{
+		Type:             m.Type,
+		ClientCN:         m.ClientCN,
+		ProducerByteRate: m.ProducerByteRate,
+	}
+}
+
+// portalClientCN client_cn квоты в формате портала, для квоты по умолчанию "<default>"
+func (m KafkaQuotaResourceModel) portalClientCN() string {
+	if m.Type.ValueString() == "default" {
+		return "<default>"
+	}
+	return m.ClientCN.ValueString()
+}
+
+func (r KafkaQuotaResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Квота клиента (или квота по умолчанию) кластера Kafka. Чтобы кластер не удалял " +
+			"такие квоты, укажите у него `quotas_ownership = \"shared\"`.",
+		Attributes: map[string]schema.Attribute{
+			"kafka_order_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Идентификатор заказа кластера Kafka.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Тип квоты (one of [default, personal])",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("default", "personal"),
+				},
+			},
+			"client_cn": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CN клиентского сертификата. Он может быть использован только для персональных квот.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(3),
+					stringvalidator.LengthAtMost(64),
+					stringvalidator.NoneOf("default", "<default>"),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\.\-_]*$`),
+						"may contain uppercase/lowercase Latin letters, numbers, "+
+							"punctuation marks ['.' '-' '_']. (cannot start with punctuation)",
+					),
+				},
+			},
+			"producer_byte_rate": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Скорость передачи байтов квоты производителя.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(131072),
+					int64validator.AtMost(52428800),
+				},
+			},
+		},
+	}
+}
+
+func (r KafkaQuotaResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config KafkaQuotaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Type.IsUnknown() || config.ClientCN.IsUnknown() {
+		return
+	}
+
+	validateQuotaClientCN(config.quota(), path.Root("client_cn"), &resp.Diagnostics)
+}
+
+func (r KafkaQuotaResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan KafkaQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.KafkaOrderID.IsUnknown() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("kafka_order_id"),
+			consts.MODIFY_PLAN_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	orderItem, err := order.GetParentItem()
+	if err != nil {
+		resp.Diagnostics.AddError(consts.MODIFY_PLAN_FAIL, err.Error())
+		return
+	}
+	kafkaConfig, err := entities.ItemConfig[entities.KafkaItemConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.MODIFY_PLAN_FAIL, err.Error())
+		return
+	}
+	validateQuotasKafkaVersion(kafkaConfig.KafkaVersion, path.Root("type"), &resp.Diagnostics)
+
+	if findKafkaQuota(kafkaConfig.Quotas, plan.portalClientCN()) != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("client_cn"),
+			consts.MODIFY_PLAN_FAIL,
+			fmt.Sprintf(
+				"Quota for '%s' already exists in kafka order '%s'. Import it with `tofu import` instead",
+				plan.portalClientCN(), plan.KafkaOrderID.ValueString(),
+			),
+		)
+	}
+}
+
+func (r KafkaQuotaResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan KafkaQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	err = order.CreateOrUpdateQuotas([]orders.KafkaQuotasBulkAction{plan.bulkAction()}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf("Quota for '%s' wasn't created.\nError: %s", plan.portalClientCN(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r KafkaQuotaResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state KafkaQuotaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, state.KafkaOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				state.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	quotas, err := order.GetQuotas()
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, fmt.Sprintf("Can't get kafka quotas.\nError: %s", err.Error()))
+		return
+	}
+
+	quota := findKafkaQuota(quotas, state.portalClientCN())
+	if quota == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	actual := convertQuotaToTerraform(*quota)
+	state.Type = actual.Type
+	state.ClientCN = actual.ClientCN
+	state.ProducerByteRate = actual.ProducerByteRate
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r KafkaQuotaResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan KafkaQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	err = order.CreateOrUpdateQuotas([]orders.KafkaQuotasBulkAction{plan.bulkAction()}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf("Quota for '%s' wasn't updated.\nError: %s", plan.portalClientCN(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r KafkaQuotaResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state KafkaQuotaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, state.KafkaOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				state.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	err = order.DeleteQuotas([]orders.KafkaQuotasBulkAction{
+		{ClientCNs: []string{state.portalClientCN()}},
+	}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf("Quota for '%s' wasn't deleted.\nError: %s", state.portalClientCN(), err.Error()),
+		)
+	}
+}
+
+// ImportState принимает идентификатор в формате <order_id>/<client_cn> или <order_id>/default
+func (r KafkaQuotaResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	clientCN, ok := utils.ImportCompositeState(
+		ctx, r.client, path.Root("kafka_order_id"), kafkaItemKinds, "<order_id>/<client_cn> or <order_id>/default", req, resp,
+	)
+	if !ok {
+		return
+	}
+	if clientCN == "default" || clientCN == "<default>" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), "default")...)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), "personal")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("client_cn"), clientCN)...)
+}
+
+func (m KafkaQuotaResourceModel) bulkAction() orders.KafkaQuotasBulkAction {
+	action := orders.KafkaQuotasBulkAction{
+		QuotaType:        m.Type.ValueString(),
+		ProducerByteRate: m.ProducerByteRate.ValueInt64(),
+	}
+	if m.Type.ValueString() != "default" {
+		action.ClientCNs = []string{m.ClientCN.ValueString()}
+	}
+	return action
+}
+
+func findKafkaQuota(quotas []entities.KafkaQuota, clientCN string) *entities.KafkaQuota {
+	for i := range quotas {
+		if quotas[i].ClientCN == clientCN {
+			return &quotas[i]
+		}
+	}
+	return nil
+}
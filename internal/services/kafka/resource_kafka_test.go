@@ -0,0 +1,337 @@
+package kafka
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"terraform-provider-vtb/internal/services/flavor"
+	"terraform-provider-vtb/pkg/client/entities"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestManagedNames(t *testing.T) {
+	stateTopics := map[string]TopicModel{"orders": {}}
+
+	cases := []struct {
+		name      string
+		ownership types.String
+		managed   map[string]bool
+	}{
+		{
+			name:      "exclusive",
+			ownership: types.StringValue("exclusive"),
+			managed:   map[string]bool{"orders": true, "external": true},
+		},
+		{
+			name:      "not set",
+			ownership: types.StringNull(),
+			managed:   map[string]bool{"orders": true, "external": true},
+		},
+		{
+			name:      "shared",
+			ownership: types.StringValue("shared"),
+			managed:   map[string]bool{"orders": true, "external": false},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			managed := managedNames(c.ownership, stateTopics)
+			for name, want := range c.managed {
+				if got := managed(name); got != want {
+					t.Errorf("managed(%q) = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestManagedNamesSharedWithoutState(t *testing.T) {
+	managed := managedNames[TopicModel](types.StringValue("shared"), nil)
+	if managed("orders") {
+		t.Errorf("shared cluster without state must not manage order topics")
+	}
+}
+
+func TestFilterManagedTopics(t *testing.T) {
+	topics := []entities.KafkaTopic{
+		{TopicName: "orders", PartitionsNumber: 3},
+		{TopicName: "external", PartitionsNumber: 1},
+		{TopicName: "events", PartitionsNumber: 1},
+	}
+
+	cases := []struct {
+		name    string
+		managed func(string) bool
+		want    []string
+	}{
+		{
+			name:    "all",
+			managed: func(string) bool { return true },
+			want:    []string{"orders", "external", "events"},
+		},
+		{
+			name:    "none",
+			managed: func(string) bool { return false },
+			want:    nil,
+		},
+		{
+			name: "shared",
+			managed: managedNames(types.StringValue("shared"), map[string]TopicModel{
+				"orders": {},
+				"events": {},
+			}),
+			want: []string{"orders", "events"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var names []string
+			for _, topic := range filterManagedTopics(topics, c.managed) {
+				names = append(names, topic.TopicName)
+			}
+			if !reflect.DeepEqual(names, c.want) {
+				t.Errorf("filterManagedTopics() = %v, want %v", names, c.want)
+			}
+		})
+	}
+}
+
+func TestFilterManagedACLs(t *testing.T) {
+	accessACLs := []entities.KafkaACL{
+		{ClientCN: "cluster-client", ClientRole: "consumer", Type: "by_name", TopicNames: []string{"orders"}},
+		{ClientCN: "external-client", ClientRole: "producer", Type: "by_mask", Name: "orders."},
+	}
+	transactionalACLs := []entities.KafkaTransactionalACL{
+		{ClientCN: "external-client", Type: "by_name", Value: "tx"},
+	}
+	idempotentACLs := []entities.KafkaIdempotentACL{
+		{ClientCN: "cluster-client"},
+		{ClientCN: "external-client"},
+	}
+	managed := managedNames(types.StringValue("shared"), map[string]ClientACLsModel{"cluster-client": {}})
+	access, transactional, idempotent := filterManagedACLs(
+		managed, accessACLs, transactionalACLs, idempotentACLs,
+	)
+
+	if !reflect.DeepEqual(access, accessACLs[:1]) {
+		t.Errorf("access ACLs = %v, want %v", access, accessACLs[:1])
+	}
+	if len(transactional) != 0 {
+		t.Errorf("transactional ACLs = %v, want none", transactional)
+	}
+	if !reflect.DeepEqual(idempotent, idempotentACLs[:1]) {
+		t.Errorf("idempotent ACLs = %v, want %v", idempotent, idempotentACLs[:1])
+	}
+
+	access, transactional, idempotent = filterManagedACLs(
+		managedNames[ClientACLsModel](types.StringValue("exclusive"), nil),
+		accessACLs, transactionalACLs, idempotentACLs,
+	)
+	if len(access) != 2 || len(transactional) != 1 || len(idempotent) != 2 {
+		t.Errorf("exclusive cluster must manage all ACLs, got %v %v %v", access, transactional, idempotent)
+	}
+}
+
+func TestReadQuotas(t *testing.T) {
+	ctx := context.Background()
+	kafkaConfig := entities.KafkaItemConfig{
+		Quotas: []entities.KafkaQuota{
+			{ClientCN: "<default>", ProducerByteRate: 131072},
+			{ClientCN: "cluster-client", ProducerByteRate: 262144},
+			{ClientCN: "external-client", ProducerByteRate: 524288},
+		},
+	}
+	stateQuotas := map[string]QuotaModel{
+		"default":        {},
+		"cluster-client": {},
+	}
+
+	cases := []struct {
+		name      string
+		ownership types.String
+		want      []string
+	}{
+		{"exclusive", types.StringValue("exclusive"), []string{"cluster-client", "default", "external-client"}},
+		{"shared", types.StringValue("shared"), []string{"cluster-client", "default"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var quotas []QuotaModel
+			diags := readQuotas(ctx, kafkaConfig, managedNames(c.ownership, stateQuotas)).ElementsAs(ctx, &quotas, false)
+			if diags.HasError() {
+				t.Fatalf("readQuotas() diagnostics: %v", diags)
+			}
+			var keys []string
+			for _, quota := range quotas {
+				keys = append(keys, quota.key())
+			}
+			sort.Strings(keys)
+			if !reflect.DeepEqual(keys, c.want) {
+				t.Errorf("readQuotas() keys = %v, want %v", keys, c.want)
+			}
+		})
+	}
+}
+
+func TestConvertQuotaToTerraform(t *testing.T) {
+	defaultQuota := convertQuotaToTerraform(entities.KafkaQuota{ClientCN: "<default>", ProducerByteRate: 131072})
+	if defaultQuota.Type.ValueString() != "default" || !defaultQuota.ClientCN.IsNull() || defaultQuota.key() != "default" {
+		t.Errorf("unexpected default quota: %v", defaultQuota)
+	}
+
+	personalQuota := convertQuotaToTerraform(entities.KafkaQuota{ClientCN: "client", ProducerByteRate: 262144})
+	if personalQuota.Type.ValueString() != "personal" || personalQuota.key() != "client" ||
+		personalQuota.ProducerByteRate.ValueInt64() != 262144 {
+		t.Errorf("unexpected personal quota: %v", personalQuota)
+	}
+}
+
+func TestValidateChangesWhilePoweredOff(t *testing.T) {
+	ctx := context.Background()
+	topics := func(names ...string) types.Map {
+		elements := map[string]TopicModel{}
+		for _, name := range names {
+			elements[name] = TopicModel{
+				CleanupPolicy:      types.StringValue("delete"),
+				Partitions:         types.Int64Value(1),
+				SegmentSizeMb:      types.Int64Value(1024),
+				RetentionMs:        types.Int64Value(86400000),
+				RetentionBytes:     types.Int64Null(),
+				CompressionType:    types.StringValue("default"),
+				AllowTopicRecreate: types.BoolValue(false),
+			}
+		}
+		value, _ := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: TopicModel{}.AttributeTypes()}, elements)
+		return value
+	}
+
+	cases := []struct {
+		name       string
+		statePower string
+		modify     func(plan *KafkaClusterResourceModel)
+		wantErrors []string
+	}{
+		{
+			name:       "running cluster",
+			statePower: "on",
+			modify: func(plan *KafkaClusterResourceModel) {
+				plan.Topics = topics("orders", "events")
+				plan.Flavor = flavor.FlavorModel{Name: types.StringValue("c4m8")}
+			},
+		},
+		{
+			name:       "stopped cluster without changes",
+			statePower: "off",
+			modify:     func(plan *KafkaClusterResourceModel) {},
+		},
+		{
+			name:       "stopped cluster",
+			statePower: "off",
+			modify: func(plan *KafkaClusterResourceModel) {
+				plan.Topics = topics("orders", "events")
+				plan.Flavor = flavor.FlavorModel{Name: types.StringValue("c4m8")}
+				plan.Label = types.StringValue("new-label")
+			},
+			wantErrors: []string{"topics", "flavor"},
+		},
+		{
+			name:       "unknown topics",
+			statePower: "off",
+			modify: func(plan *KafkaClusterResourceModel) {
+				plan.Topics = types.MapUnknown(types.ObjectType{AttrTypes: TopicModel{}.AttributeTypes()})
+			},
+		},
+		{
+			name:       "started in the same plan",
+			statePower: "off",
+			modify: func(plan *KafkaClusterResourceModel) {
+				plan.PowerState = types.StringValue("on")
+				plan.Topics = topics("orders", "events")
+			},
+		},
+		{
+			name:       "stopped in the same plan",
+			statePower: "on",
+			modify: func(plan *KafkaClusterResourceModel) {
+				plan.PowerState = types.StringValue("off")
+				plan.Topics = topics("orders", "events")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := KafkaClusterResourceModel{
+				Label:      types.StringValue("label"),
+				Flavor:     flavor.FlavorModel{Name: types.StringValue("c2m4")},
+				Topics:     topics("orders"),
+				ACLs:       types.MapNull(types.ObjectType{AttrTypes: ClientACLsModel{}.AttributeTypes()}),
+				PowerState: types.StringValue(c.statePower),
+			}
+			plan := state
+			c.modify(&plan)
+
+			var diags diag.Diagnostics
+			validateChangesWhilePoweredOff(&state, &plan, &diags)
+
+			var attrs []string
+			for _, d := range diags.Errors() {
+				attrs = append(attrs, d.(diag.DiagnosticWithPath).Path().String())
+			}
+			if !reflect.DeepEqual(attrs, c.wantErrors) {
+				t.Errorf("errors for %v, want %v: %v", attrs, c.wantErrors, diags)
+			}
+		})
+	}
+}
+
+func TestPlannedActionsPowerState(t *testing.T) {
+	cases := []struct {
+		name           string
+		statePower     string
+		planPower      string
+		label          string
+		wantNames      []string
+		wantDisruptive bool
+	}{
+		{"unchanged", "on", "on", "kafka", nil, false},
+		{"stop", "on", "off", "kafka", []string{"stop_kafka"}, true},
+		{"start", "off", "on", "kafka", []string{"start_kafka"}, false},
+		{"start before other changes", "off", "on", "kafka-new", []string{"start_kafka", "change_label"}, false},
+		{"stop after other changes", "on", "off", "kafka-new", []string{"change_label", "stop_kafka"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := KafkaClusterResourceModel{
+				Topics:     types.MapNull(types.ObjectType{AttrTypes: TopicModel{}.AttributeTypes()}),
+				ACLs:       types.MapNull(types.ObjectType{AttrTypes: ClientACLsModel{}.AttributeTypes()}),
+				Quotas:     types.SetNull(types.ObjectType{AttrTypes: QuotaModel{}.AttributeTypes()}),
+				PowerState: types.StringValue(c.statePower),
+				Label:      types.StringValue("kafka"),
+			}
+			plan := state
+			plan.PowerState = types.StringValue(c.planPower)
+			plan.Label = types.StringValue(c.label)
+
+			actions := plannedActions(&state, &plan)
+			var names []string
+			for _, action := range actions {
+				names = append(names, action.Name)
+			}
+			if !reflect.DeepEqual(names, c.wantNames) {
+				t.Errorf("plannedActions() = %v, want %v", names, c.wantNames)
+			}
+			if actions.HasDisruptive() != c.wantDisruptive {
+				t.Errorf("HasDisruptive() = %v, want %v", actions.HasDisruptive(), c.wantDisruptive)
+			}
+		})
+	}
+}
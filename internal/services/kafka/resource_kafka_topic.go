@@ -0,0 +1,449 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/internal/utils"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &KafkaTopicResource{}
+	_ resource.ResourceWithImportState    = &KafkaTopicResource{}
+	_ resource.ResourceWithValidateConfig = &KafkaTopicResource{}
+	_ resource.ResourceWithModifyPlan     = &KafkaTopicResource{}
+)
+
+type KafkaTopicResource struct {
+	client *client.CloudClient
+}
+
+func NewKafkaTopicResource() resource.Resource {
+	return &KafkaTopicResource{}
+}
+
+func (r KafkaTopicResource) Metadata(
+	ctx context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_kafka_topic"
+}
+
+func (r *KafkaTopicResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.CloudClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *client.CloudClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+type KafkaTopicResourceModel struct {
+	KafkaOrderID       types.String `tfsdk:"kafka_order_id"`
+	Name               types.String `tfsdk:"name"`
+	CleanupPolicy      types.String `tfsdk:"cleanup_policy"`
+	Partitions         types.Int64  `tfsdk:"partitions"`
+	SegmentSizeMb      types.Int64  `tfsdk:"segment_size_mb"`
+	RetentionMs        types.Int64  `tfsdk:"retention_ms"`
+	RetentionBytes     types.Int64  `tfsdk:"retention_bytes"`
+	CompressionType    types.String `tfsdk:"compression_type"`
+	AllowTopicRecreate types.Bool   `tfsdk:"allow_topic_recreate"`
+}
+
+func (r KafkaTopicResource) Schema(
+	ctx context.Context,
+	req resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Топик кластера Kafka, управляемый отдельно от ресурса `vtb_kafka_instance`. " +
+			"Чтобы кластер не удалял такие топики, укажите у него `topics_ownership = \"shared\"`.",
+		Attributes: map[string]schema.Attribute{
+			"kafka_order_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Идентификатор заказа кластера Kafka.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Имя топика.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: topicNameValidators(),
+			},
+			"cleanup_policy": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Политика очистки для данного топика.",
+				Validators:          topicCleanupPolicyValidators(),
+			},
+			"partitions": schema.Int64Attribute{
+				Required: true,
+				MarkdownDescription: "Количество разделов в топике. Уменьшение количества разделов " +
+					"пересоздает топик и требует `allow_topic_recreate`.",
+				Validators: topicPartitionsValidators(),
+			},
+			"segment_size_mb": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(topicDefaultSegmentSizeMb),
+				MarkdownDescription: "Максимальный размер для хранения данных в разделах указан в Мб.",
+				Validators:          topicSegmentSizeValidators(),
+			},
+			"retention_ms": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Время удаления сегмента с данными, указанное в миллисекундах.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: topicRetentionMsValidators(),
+			},
+			"retention_bytes": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Максимальный размер для хранения данных в разделах указан в байтах.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: topicRetentionBytesValidators(),
+			},
+			"compression_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(topicDefaultCompressionType),
+				MarkdownDescription: "Тип сжатия",
+				Validators:          topicCompressionTypeValidators(),
+			},
+			"allow_topic_recreate": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "Разрешить удаление и создание топика заново для изменений, " +
+					"которые нельзя применить на месте (уменьшение `partitions`). " +
+					"Все данные топика будут потеряны.",
+			},
+		},
+	}
+}
+
+func (r KafkaTopicResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.CleanupPolicy.IsUnknown() {
+		return
+	}
+
+	retentionSet := !config.RetentionMs.IsNull() || !config.RetentionBytes.IsNull()
+	switch config.CleanupPolicy.ValueString() {
+	case "delete", "delete,compact":
+		if !retentionSet && !config.RetentionMs.IsUnknown() && !config.RetentionBytes.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cleanup_policy"),
+				consts.VALIDATION_FAIL,
+				fmt.Sprintf(
+					"At least one of `retention_ms`, `retention_bytes` must be specified when cleanup_policy is '%s'",
+					config.CleanupPolicy.ValueString(),
+				),
+			)
+		}
+	case "compact":
+		if retentionSet {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cleanup_policy"),
+				consts.VALIDATION_FAIL,
+				"`retention_ms` and `retention_bytes` conflict with cleanup_policy 'compact'",
+			)
+		}
+	}
+}
+
+func (r KafkaTopicResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !plan.Name.Equal(state.Name) || !plan.KafkaOrderID.Equal(state.KafkaOrderID) {
+		return
+	}
+
+	validateTopicChange(
+		plan.Name.ValueString(),
+		state.topicModel(),
+		plan.topicModel(),
+		path.Root("name"),
+		&resp.Diagnostics,
+	)
+}
+
+func (r KafkaTopicResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	orderTopics, err := order.GetTopics()
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, fmt.Sprintf("Can't get kafka topics.\nError: %s", err.Error()))
+		return
+	}
+	if findKafkaTopic(orderTopics, plan.Name.ValueString()) != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf(
+				"Topic '%s' already exists in kafka order '%s'. Import it with `tofu import` instead",
+				plan.Name.ValueString(), plan.KafkaOrderID.ValueString(),
+			),
+		)
+		return
+	}
+
+	err = order.CreateTopics([]entities.KafkaTopic{plan.toEntity()}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.CREATE_RES_FAIL,
+			fmt.Sprintf("Topic '%s' wasn't created.\nError: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r KafkaTopicResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, state.KafkaOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				state.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	orderTopics, err := order.GetTopics()
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, fmt.Sprintf("Can't get kafka topics.\nError: %s", err.Error()))
+		return
+	}
+
+	topic := findKafkaTopic(orderTopics, state.Name.ValueString())
+	if topic == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.CleanupPolicy = types.StringValue(topic.CleanupPolicy)
+	state.Partitions = types.Int64Value(topic.PartitionsNumber)
+	state.SegmentSizeMb = types.Int64Value(bytesToMb(topic.SegmentSize))
+	state.CompressionType = types.StringValue(topic.CompressionType)
+	state.RetentionMs = types.Int64Null()
+	if topic.RetentionMs != 0 {
+		state.RetentionMs = types.Int64Value(topic.RetentionMs)
+	}
+	state.RetentionBytes = types.Int64Null()
+	if topic.RetentionBytes != 0 {
+		state.RetentionBytes = types.Int64Value(topic.RetentionBytes)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r KafkaTopicResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, plan.KafkaOrderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				plan.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	var state KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	change := classifyTopicChange(state.toEntity(), plan.toEntity())
+	if change.Kind == topicChangeDestructive && plan.AllowTopicRecreate.ValueBool() {
+		diags := recreateTopics(order, []entities.KafkaTopic{plan.toEntity()})
+		resp.Diagnostics.Append(warningsAsErrors(consts.UPDATE_RES_FAIL, diags)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	err = order.EditTopics([]entities.KafkaTopic{plan.toEntity()}, false)
+	if err != nil && !strings.Contains(err.Error(), "hasn't changes") {
+		resp.Diagnostics.AddError(
+			consts.UPDATE_RES_FAIL,
+			fmt.Sprintf("Topic '%s' wasn't edited.\nError: %s", plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r KafkaTopicResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state KafkaTopicResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := orders.GetKafkaOrder(r.client.Creds, r.client.ProjectName, state.KafkaOrderID.ValueString())
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			return
+		}
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf(
+				"Can't get kafka order with order_id '%s'.\nError: %s",
+				state.KafkaOrderID.ValueString(), err.Error(),
+			),
+		)
+		return
+	}
+
+	err = order.DeleteTopics([]entities.KafkaTopic{state.toEntity()}, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			consts.DELETE_RES_FAIL,
+			fmt.Sprintf("Topic '%s' wasn't deleted.\nError: %s", state.Name.ValueString(), err.Error()),
+		)
+	}
+}
+
+// ImportState принимает идентификатор в формате <order_id>/<topic>
+func (r KafkaTopicResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	topicName, ok := utils.ImportCompositeState(
+		ctx, r.client, path.Root("kafka_order_id"), kafkaItemKinds, "<order_id>/<topic>", req, resp,
+	)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), topicName)...)
+}
+
+func (m KafkaTopicResourceModel) topicModel() TopicModel {
+	return TopicModel{
+		CleanupPolicy:      m.CleanupPolicy,
+		Partitions:         m.Partitions,
+		SegmentSizeMb:      m.SegmentSizeMb,
+		RetentionMs:        m.RetentionMs,
+		RetentionBytes:     m.RetentionBytes,
+		CompressionType:    m.CompressionType,
+		AllowTopicRecreate: m.AllowTopicRecreate,
+	}
+}
+
+func (m KafkaTopicResourceModel) toEntity() entities.KafkaTopic {
+	return topicModelToEntity(m.Name.ValueString(), m.topicModel())
+}
+
+func findKafkaTopic(topics []entities.KafkaTopic, topicName string) *entities.KafkaTopic {
+	for i := range topics {
+		if topics[i].TopicName == topicName {
+			return &topics[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package kafka
+
+import (
+	"fmt"
+	"strings"
+
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/entities"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// topicChangeKind класс изменения топика относительно портала
+type topicChangeKind int
+
+const (
+	topicChangeNone topicChangeKind = iota
+	// topicChangeInPlace изменение применяется действием редактирования топика
+	topicChangeInPlace
+	// topicChangeDestructive изменение применяется только удалением и созданием топика заново
+	topicChangeDestructive
+	// topicChangeUnsupported изменение не может быть применено порталом
+	topicChangeUnsupported
+)
+
+type topicChange struct {
+	Kind    topicChangeKind
+	Reasons []string
+}
+
+func (c *topicChange) add(kind topicChangeKind, format string, args ...any) {
+	if kind > c.Kind {
+		c.Kind = kind
+	}
+	if kind != topicChangeInPlace {
+		c.Reasons = append(c.Reasons, fmt.Sprintf(format, args...))
+	}
+}
+
+// classifyTopicChange определяет, как портал может применить переход топика из current в planned
+func classifyTopicChange(current, planned entities.KafkaTopic) topicChange {
+	var change topicChange
+
+	switch {
+	case planned.PartitionsNumber < current.PartitionsNumber:
+		change.add(
+			topicChangeDestructive,
+			"partitions can't be reduced from %d to %d, kafka only adds partitions",
+			current.PartitionsNumber, planned.PartitionsNumber,
+		)
+	case planned.PartitionsNumber > current.PartitionsNumber:
+		change.add(topicChangeInPlace, "")
+	}
+
+	// политика переключается на месте операцией change_cleanup_policy действия редактирования
+	if planned.CleanupPolicy != current.CleanupPolicy {
+		change.add(topicChangeInPlace, "")
+	}
+
+	// действие редактирования не передает нулевые значения, поэтому снять ограничение нельзя
+	if current.RetentionMs != 0 && planned.RetentionMs == 0 && planned.CleanupPolicy == current.CleanupPolicy {
+		change.add(
+			topicChangeUnsupported,
+			"retention_ms can't be removed from existing topic, set an explicit value instead",
+		)
+	} else if planned.RetentionMs != current.RetentionMs {
+		change.add(topicChangeInPlace, "")
+	}
+
+	if current.RetentionBytes != 0 && planned.RetentionBytes == 0 && planned.CleanupPolicy == current.CleanupPolicy {
+		change.add(
+			topicChangeUnsupported,
+			"retention_bytes can't be removed from existing topic, set an explicit value instead",
+		)
+	} else if planned.RetentionBytes != current.RetentionBytes {
+		change.add(topicChangeInPlace, "")
+	}
+
+	if planned.SegmentSize != current.SegmentSize || planned.CompressionType != current.CompressionType {
+		change.add(topicChangeInPlace, "")
+	}
+	return change
+}
+
+// validateTopicChange проверяет изменение топика на этапе плана. Неподдерживаемые изменения
+// и пересоздание без allow_topic_recreate завершают план ошибкой
+func validateTopicChange(
+	topicName string,
+	current, planned TopicModel,
+	attrPath path.Path,
+	diags *diag.Diagnostics,
+) {
+	if planned.CleanupPolicy.IsUnknown() || planned.Partitions.IsUnknown() ||
+		planned.RetentionMs.IsUnknown() || planned.RetentionBytes.IsUnknown() {
+		return
+	}
+
+	change := classifyTopicChange(topicModelToEntity(topicName, current), topicModelToEntity(topicName, planned))
+	switch change.Kind {
+	case topicChangeUnsupported:
+		diags.AddAttributeError(
+			attrPath,
+			consts.MODIFY_PLAN_FAIL,
+			fmt.Sprintf(
+				"Topic '%s' has changes unsupported by portal:\n- %s",
+				topicName, strings.Join(change.Reasons, "\n- "),
+			),
+		)
+	case topicChangeDestructive:
+		if !planned.AllowTopicRecreate.ValueBool() {
+			diags.AddAttributeError(
+				attrPath,
+				consts.MODIFY_PLAN_FAIL,
+				fmt.Sprintf(
+					"Topic '%s' must be deleted and created again to apply changes:\n- %s\n"+
+						"All topic data will be lost. Set `allow_topic_recreate = true` for the topic to allow it.",
+					topicName, strings.Join(change.Reasons, "\n- "),
+				),
+			)
+			return
+		}
+		diags.AddAttributeWarning(
+			attrPath,
+			"Topic will be recreated",
+			fmt.Sprintf(
+				"Topic '%s' will be deleted and created again, all topic data will be lost:\n- %s",
+				topicName, strings.Join(change.Reasons, "\n- "),
+			),
+		)
+	}
+}
+
+func topicModelToEntity(topicName string, topic TopicModel) entities.KafkaTopic {
+	return entities.KafkaTopic{
+		TopicName:        topicName,
+		CleanupPolicy:    topic.CleanupPolicy.ValueString(),
+		PartitionsNumber: topic.Partitions.ValueInt64(),
+		RetentionMs:      topic.RetentionMs.ValueInt64(),
+		RetentionBytes:   topic.RetentionBytes.ValueInt64(),
+		SegmentSize:      mbToBytes(topic.SegmentSizeMb.ValueInt64()),
+		CompressionType:  topic.CompressionType.ValueString(),
+	}
+}
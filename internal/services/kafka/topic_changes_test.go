@@ -0,0 +1,123 @@
+package kafka
+
+import (
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/entities"
+)
+
+func TestClassifyTopicChange(t *testing.T) {
+	current := entities.KafkaTopic{
+		TopicName:        "orders",
+		CleanupPolicy:    "delete",
+		PartitionsNumber: 3,
+		RetentionMs:      86400000,
+		RetentionBytes:   134217728,
+		SegmentSize:      mbToBytes(1024),
+		CompressionType:  "default",
+	}
+
+	cases := []struct {
+		name        string
+		modify      func(planned *entities.KafkaTopic)
+		wantKind    topicChangeKind
+		wantReasons int
+	}{
+		{
+			name:     "no changes",
+			modify:   func(planned *entities.KafkaTopic) {},
+			wantKind: topicChangeNone,
+		},
+		{
+			name:     "partitions increased",
+			modify:   func(planned *entities.KafkaTopic) { planned.PartitionsNumber = 6 },
+			wantKind: topicChangeInPlace,
+		},
+		{
+			name:        "partitions reduced",
+			modify:      func(planned *entities.KafkaTopic) { planned.PartitionsNumber = 1 },
+			wantKind:    topicChangeDestructive,
+			wantReasons: 1,
+		},
+		{
+			name: "delete to compact",
+			modify: func(planned *entities.KafkaTopic) {
+				planned.CleanupPolicy = "compact"
+				planned.RetentionMs = 0
+				planned.RetentionBytes = 0
+			},
+			wantKind: topicChangeInPlace,
+		},
+		{
+			name:     "delete to delete,compact",
+			modify:   func(planned *entities.KafkaTopic) { planned.CleanupPolicy = "delete,compact" },
+			wantKind: topicChangeInPlace,
+		},
+		{
+			name: "retention_bytes dropped with cleanup_policy switched",
+			modify: func(planned *entities.KafkaTopic) {
+				planned.CleanupPolicy = "delete,compact"
+				planned.RetentionBytes = 0
+			},
+			wantKind: topicChangeInPlace,
+		},
+		{
+			name: "segment size and compression",
+			modify: func(planned *entities.KafkaTopic) {
+				planned.SegmentSize = mbToBytes(256)
+				planned.CompressionType = "zstd"
+			},
+			wantKind: topicChangeInPlace,
+		},
+		{
+			name:     "retention_ms changed",
+			modify:   func(planned *entities.KafkaTopic) { planned.RetentionMs = 1800000 },
+			wantKind: topicChangeInPlace,
+		},
+		{
+			name:        "retention_ms removed",
+			modify:      func(planned *entities.KafkaTopic) { planned.RetentionMs = 0 },
+			wantKind:    topicChangeUnsupported,
+			wantReasons: 1,
+		},
+		{
+			name:        "retention_bytes removed",
+			modify:      func(planned *entities.KafkaTopic) { planned.RetentionBytes = 0 },
+			wantKind:    topicChangeUnsupported,
+			wantReasons: 1,
+		},
+		{
+			name: "partitions reduced with cleanup_policy switched",
+			modify: func(planned *entities.KafkaTopic) {
+				planned.PartitionsNumber = 1
+				planned.CleanupPolicy = "delete,compact"
+			},
+			wantKind:    topicChangeDestructive,
+			wantReasons: 1,
+		},
+		{
+			name: "partitions reduced with retention_ms removed",
+			modify: func(planned *entities.KafkaTopic) {
+				planned.PartitionsNumber = 1
+				planned.RetentionMs = 0
+			},
+			wantKind:    topicChangeUnsupported,
+			wantReasons: 2,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			planned := current
+			c.modify(&planned)
+
+			change := classifyTopicChange(current, planned)
+			if change.Kind != c.wantKind {
+				t.Errorf("Kind = %v, want %v (%v)", change.Kind, c.wantKind, change.Reasons)
+			}
+			if len(change.Reasons) != c.wantReasons {
+				t.Errorf("Reasons = %v, want %d", change.Reasons, c.wantReasons)
+			}
+		})
+	}
+}
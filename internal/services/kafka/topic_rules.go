@@ -0,0 +1,78 @@
+package kafka
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ограничения портала на параметры топика. Используются схемами ресурсов
+// vtb_kafka_instance, vtb_kafka_topic, vtb_kafka_acl и проверкой каталога топиков
+const (
+	topicNameMaxLength = 255
+	topicNameMessage   = "may contain uppercase/lowercase Latin letters, numbers, " +
+		"punctuation marks ['.' '-' '_']. (cannot start with punctuation)"
+
+	topicPartitionsMin = 1
+	topicPartitionsMax = 50
+
+	topicRetentionMsMin    = 1800000
+	topicRetentionMsMax    = 7776000000
+	topicRetentionBytesMin = 134217728
+	topicRetentionBytesMax = 1000000000000
+
+	topicDefaultSegmentSizeMb   = 1024
+	topicDefaultCompressionType = "default"
+)
+
+var (
+	topicNameRegexp       = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\.\-_]*$`)
+	topicSegmentSizesMb   = []int64{1024, 512, 256, 128}
+	topicCompressionTypes = []string{"default", "uncompressed", "zstd", "lz4", "snappy", "gzip"}
+	topicCleanupPolicies  = []string{"delete", "compact", "delete,compact"}
+)
+
+func topicNameValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.LengthBetween(1, topicNameMaxLength),
+		stringvalidator.RegexMatches(topicNameRegexp, topicNameMessage),
+	}
+}
+
+func topicCleanupPolicyValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(topicCleanupPolicies...),
+	}
+}
+
+func topicPartitionsValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.Between(topicPartitionsMin, topicPartitionsMax),
+	}
+}
+
+func topicSegmentSizeValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.OneOf(topicSegmentSizesMb...),
+	}
+}
+
+func topicRetentionMsValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.Between(topicRetentionMsMin, topicRetentionMsMax),
+	}
+}
+
+func topicRetentionBytesValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.Between(topicRetentionBytesMin, topicRetentionBytesMax),
+	}
+}
+
+func topicCompressionTypeValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(topicCompressionTypes...),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
 	"terraform-provider-vtb/internal/consts"
 	"terraform-provider-vtb/internal/custommodifires"
 	"terraform-provider-vtb/internal/utils"
@@ -87,6 +88,7 @@ type KTaaSResourceModel struct {
 	KafkaClusterName types.String `tfsdk:"kafka_cluster_name"`
 	Acls             types.Set    `tfsdk:"acls"`
 	GroupAcls        types.Set    `tfsdk:"group_acls"`
+	PlannedActions   types.List   `tfsdk:"planned_actions"`
 }
 
 type KTaaSAclsModel struct {
@@ -253,6 +255,7 @@ func (r KTaaSResource) Schema(
 				Description:         "ACL на группы",
 				MarkdownDescription: "ACL на группы",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -262,7 +265,11 @@ func (r KTaaSResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "paas_ktaas", Provider: "ktaas"}},
+		req, resp,
+	)
 }
 
 func (r KTaaSResource) ModifyPlan(
@@ -277,12 +284,32 @@ func (r KTaaSResource) ModifyPlan(
 	var plan KTaaSResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "paas_ktaas", "ktaas")
 	resp.Diagnostics.Append(checkOrderIsDeleted.Diagnostics...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state KTaaSResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		actions, diags = r.plannedActions(ctx, &state, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r KTaaSResource) ValidateConfig(
@@ -436,6 +463,7 @@ func (r KTaaSResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(parentItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 
@@ -527,6 +555,7 @@ func (r KTaaSResource) Read(
 		KafkaClusterName: types.StringValue(config.ResourcePool.ResourcePoolName),
 		Acls:             tfAcls,
 		GroupAcls:        tfGroupAcls,
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -550,6 +579,17 @@ func (r KTaaSResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	actions, diags := r.plannedActions(ctx, &state, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(ctx, r.client, req.Plan, actions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetKTaaSOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -745,6 +785,57 @@ func (r KTaaSResource) generateBillingFlavor(
 }
 
 // Изменение источника финансирования
+// plannedActions действия портала в порядке их вызова в Update
+func (r KTaaSResource) plannedActions(
+	ctx context.Context,
+	state, plan *KTaaSResourceModel,
+) (actions utils.PlannedActions, diags diag.Diagnostics) {
+	if !plan.Label.Equal(state.Label) {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.TopicFlavor.Equal(state.TopicFlavor) {
+		actions.Add("ktaas_change_size_topic", "Изменение размера топика")
+	}
+
+	if !plan.PartitionsNumber.Equal(state.PartitionsNumber) {
+		actions.Add("ktaas_change_partitions_topic", "Изменение количества разделов топика")
+	}
+
+	if !plan.Acls.Equal(state.Acls) {
+		toAdd, toDelete, compareDiags := r.compareAcls(ctx, plan.Acls, state.Acls)
+		diags.Append(compareDiags...)
+		if diags.HasError() {
+			return actions, diags
+		}
+		if len(toAdd) > 0 {
+			actions.Add("ktaas_create_acls", "Создание ACL на доступ")
+		}
+		if len(toDelete) > 0 {
+			actions.Add("ktaas_delete_acls", "Удаление ACL на доступ")
+		}
+	}
+
+	if !plan.GroupAcls.Equal(state.GroupAcls) {
+		toAdd, toDelete, compareDiags := r.compareGroupAcls(ctx, plan.GroupAcls, state.GroupAcls)
+		diags.Append(compareDiags...)
+		if diags.HasError() {
+			return actions, diags
+		}
+		if len(toAdd) > 0 {
+			actions.Add("ktaas_create_group_acls", "Создание ACL на группы")
+		}
+		if len(toDelete) > 0 {
+			actions.Add("ktaas_delete_group_acls", "Удаление ACL на группы")
+		}
+	}
+	return actions, diags
+}
+
 func (r KTaaSResource) changeFinancialProject(
 	order *orders.KTaaS,
 	finProjID string,
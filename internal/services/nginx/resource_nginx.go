@@ -74,19 +74,22 @@ func (r *NginxResource) Configure(ctx context.Context, req resource.ConfigureReq
 }
 
 type NginxResourceModel struct {
-	Lifetime         types.Int64                       `tfsdk:"lifetime"`
-	Label            types.String                      `tfsdk:"label"`
-	OrderID          types.String                      `tfsdk:"order_id"`
-	ItemID           types.String                      `tfsdk:"item_id"`
-	Core             core.CoreModel                    `tfsdk:"core"`
-	Flavor           flavor.FlavorModel                `tfsdk:"flavor"`
-	Image            common.ImageStandardModel         `tfsdk:"image"`
-	Access           map[string][]string               `tfsdk:"access"`
-	ExtraMounts      map[string]common.ExtraMountModel `tfsdk:"extra_mounts"`
-	Hostname         types.String                      `tfsdk:"hostname"`
-	NginxVersion     types.String                      `tfsdk:"nginx_version"`
-	FinancialProject types.String                      `tfsdk:"financial_project"`
-	BuildVersion     types.String                      `tfsdk:"build_version"`
+	Lifetime            types.Int64                       `tfsdk:"lifetime"`
+	Label               types.String                      `tfsdk:"label"`
+	OrderID             types.String                      `tfsdk:"order_id"`
+	ItemID              types.String                      `tfsdk:"item_id"`
+	Core                core.CoreModel                    `tfsdk:"core"`
+	Flavor              flavor.FlavorModel                `tfsdk:"flavor"`
+	Image               common.ImageStandardModel         `tfsdk:"image"`
+	Access              map[string][]string               `tfsdk:"access"`
+	ExtraMounts         map[string]common.ExtraMountModel `tfsdk:"extra_mounts"`
+	Hostname            types.String                      `tfsdk:"hostname"`
+	NginxVersion        types.String                      `tfsdk:"nginx_version"`
+	FinancialProject    types.String                      `tfsdk:"financial_project"`
+	BuildVersion        types.String                      `tfsdk:"build_version"`
+	PlannedActions      types.List                        `tfsdk:"planned_actions"`
+	MaintenanceWindow   *common.MaintenanceWindowModel    `tfsdk:"maintenance_window"`
+	MaintenanceOverride types.Bool                        `tfsdk:"maintenance_override"`
 }
 
 func (r NginxResource) Schema(
@@ -226,6 +229,9 @@ func (r NginxResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"planned_actions":      common.PlannedActionsSchema,
+			"maintenance_window":   common.MaintenanceWindowSchema,
+			"maintenance_override": common.MaintenanceOverrideSchema,
 		},
 	}
 }
@@ -235,7 +241,11 @@ func (r NginxResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "nginx"}, {Type: "app", Provider: "nginx_develop"}},
+		req, resp,
+	)
 }
 
 func (r NginxResource) ModifyPlan(
@@ -250,6 +260,9 @@ func (r NginxResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	validateRolesDiags := utils.ValidateAccessRolesV1(
 		r.client,
 		"app:nginx",
@@ -273,6 +286,20 @@ func (r NginxResource) ModifyPlan(
 		checkOrderIsDeleted.IsDeleted,
 		checkOrderIsDeleted.Diagnostics,
 	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state NginxResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r NginxResource) Create(
@@ -342,12 +369,17 @@ func (r NginxResource) Create(
 		)
 		return
 	}
-	itemData := vmItems[0].Data
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(nginxItem.ID)
-	plan.Hostname = types.StringValue(itemData.Config.(entities.VMItemConfig).Hostname)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	plan.Hostname = types.StringValue(vmConfig.Hostname)
 	plan.BuildVersion = types.StringValue(nginxItem.Data.Build.SetupVersion)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -443,8 +475,12 @@ func (r NginxResource) Read(
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
 		NginxVersion:     types.StringValue(order.Attrs.NginxVersion),
 		BuildVersion:     types.StringValue(nginxItem.Data.Build.SetupVersion),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_window"), &state.MaintenanceWindow)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_override"), &state.MaintenanceOverride)...)
+
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
 	if !lifetime.IsNull() {
@@ -466,6 +502,17 @@ func (r NginxResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nginx, err := orders.GetNginxOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -600,6 +647,32 @@ func (r NginxResource) Delete(
 }
 
 // custom logic
+// plannedActions действия портала в порядке их вызова в Update
+func (r NginxResource) plannedActions(state, plan *NginxResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("resize_vm", "Изменение конфигурации ВМ с перезагрузкой")
+	}
+
+	if !reflect.DeepEqual(plan.Access, state.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+	return actions
+}
+
 func (r NginxResource) changeFlavorNginx(
 	order *orders.Nginx,
 	plan *NginxResourceModel,
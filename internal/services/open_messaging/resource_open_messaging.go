@@ -96,6 +96,7 @@ type OpenMessagingResourceModel struct {
 	AdminGroups     types.List `tfsdk:"admin_groups"`
 	UserGroups      types.List `tfsdk:"user_groups"`
 	SuperuserGroups types.List `tfsdk:"superuser_groups"`
+	PlannedActions  types.List `tfsdk:"planned_actions"`
 }
 
 func (r OpenMessagingResource) Schema(
@@ -221,6 +222,7 @@ func (r OpenMessagingResource) Schema(
 				Description:         "Источник финансирования заказа",
 				MarkdownDescription: "Источник финансирования заказа",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -230,7 +232,11 @@ func (r OpenMessagingResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "artemis"}, {Type: "app", Provider: "artemis_lt"}},
+		req, resp,
+	)
 }
 
 func (r OpenMessagingResource) ModifyPlan(
@@ -245,6 +251,9 @@ func (r OpenMessagingResource) ModifyPlan(
 	var plan OpenMessagingResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "vm", "openstack")
 	resp.Diagnostics.Append(checkOrderIsDeleted.Diagnostics...)
 
@@ -255,6 +264,17 @@ func (r OpenMessagingResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state OpenMessagingResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r OpenMessagingResource) Create(
@@ -351,6 +371,7 @@ func (r OpenMessagingResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -407,7 +428,11 @@ func (r OpenMessagingResource) Read(
 		return
 	}
 
-	vmConfig := vmItems[0].Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	users, err := order.GetRoleGroups("user")
 	if err != nil {
@@ -476,6 +501,7 @@ func (r OpenMessagingResource) Read(
 		AdminGroups:      tfadmins,
 		UserGroups:       tfusers,
 		SuperuserGroups:  tfsuperUsers,
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -498,6 +524,17 @@ func (r OpenMessagingResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetOpenMessagingOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -624,6 +661,40 @@ func (r OpenMessagingResource) Delete(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r OpenMessagingResource) plannedActions(state, plan *OpenMessagingResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.AdminGroups.Equal(state.AdminGroups) {
+		actions.Add("vm_acls", "Изменение групп доступа с ролью "+ADMIN_ROLE)
+	}
+
+	if !plan.UserGroups.Equal(state.UserGroups) {
+		actions.Add("vm_acls", "Изменение групп доступа с ролью "+USER_ROLE)
+	}
+
+	if !plan.SuperuserGroups.Equal(state.SuperuserGroups) {
+		actions.Add("vm_acls", "Изменение групп доступа с ролью "+SUPERUSER_ROLE)
+	}
+
+	if plan.Flavor != state.Flavor {
+		if strings.EqualFold(r.client.EnvironmentName, "lt") {
+			actions.AddDisruptive("openmessaging_lt_vertical_scaling", "Изменение конфигурации ВМ с перезагрузкой")
+		} else {
+			actions.AddDisruptive("openmessaging_vertical_scaling_release", "Изменение конфигурации ВМ с перезагрузкой")
+		}
+	}
+	return actions
+}
+
 func (r OpenMessagingResource) updateFlavor(
 	order *orders.OpenMessagingOrder,
 	plan *OpenMessagingResourceModel,
@@ -106,6 +106,7 @@ type PostgreSQLResourceModel struct {
 	Databases        map[string]DbModel            `tfsdk:"dbs"`
 	DatabaseUsers    map[string]DbUserModel        `tfsdk:"db_users"`
 	FinancialProject types.String                  `tfsdk:"financial_project"`
+	PlannedActions   types.List                    `tfsdk:"planned_actions"`
 }
 
 func (r PostgreSQLResource) Schema(
@@ -385,6 +386,7 @@ func (r PostgreSQLResource) Schema(
 				Description:         "Financial source for order.",
 				MarkdownDescription: "Financial source for order.",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -394,7 +396,11 @@ func (r PostgreSQLResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "postgresql_v001"}, {Type: "cluster", Provider: "postgresql_v001"}},
+		req, resp,
+	)
 }
 
 func (r PostgreSQLResource) ModifyPlan(
@@ -410,6 +416,9 @@ func (r PostgreSQLResource) ModifyPlan(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -443,6 +452,12 @@ func (r PostgreSQLResource) ModifyPlan(
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsOrderDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r PostgreSQLResource) Create(
@@ -547,10 +562,16 @@ func (r PostgreSQLResource) Create(
 		return
 	}
 
-	connectionUrl := orderItem.Data.Config.(entities.PostgresqlItemConfig).ConnectionURL
+	config, err := entities.ItemConfig[entities.PostgresqlItemConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	connectionUrl := config.ConnectionURL
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.ConnectionURL = types.StringValue(connectionUrl)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -614,7 +635,11 @@ func (r PostgreSQLResource) Read(
 		return
 	}
 
-	config := item.Data.Config.(entities.PostgresqlItemConfig)
+	config, err := entities.ItemConfig[entities.PostgresqlItemConfig](item)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 	dbUsersPortal, err = order.GetUsers()
 
 	if err != nil {
@@ -666,7 +691,11 @@ func (r PostgreSQLResource) Read(
 		vmItem = postgresqlVmItems[0]
 	}
 
-	vmConfig := vmItem.Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	postgresqlPgdataMount, err := order.GetExtraMount("/pg_data")
 	if err != nil {
@@ -677,7 +706,11 @@ func (r PostgreSQLResource) Read(
 		return
 	}
 
-	itemConfig := item.Data.Config.(entities.PostgresqlItemConfig)
+	itemConfig, err := entities.ItemConfig[entities.PostgresqlItemConfig](item)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	data.Label = types.StringValue(order.Label)
 	data.ConnectionURL = types.StringValue(itemConfig.ConnectionURL)
@@ -749,6 +782,7 @@ func (r PostgreSQLResource) Read(
 	if !lifetime.IsNull() {
 		data.Lifetime = lifetime
 	}
+	data.PlannedActions = utils.PriorPlannedActions(ctx, req.State)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -768,6 +802,20 @@ func (r PostgreSQLResource) Update(
 	resp.Diagnostics.Append(diags...)
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetPostgresqlOrder(
 		r.client.Creds,
@@ -1572,6 +1620,67 @@ func configureDBUsers(
 	return diags
 }
 
+// plannedActions действия портала в порядке их вызова в Update.
+// Имена действий изменения ВМ зависят от типа продукта: standalone или cluster
+func plannedActions(state, plan *PostgreSQLResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+	isCluster := plan.Image.ProductType.ValueString() == "cluster"
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		if isCluster {
+			actions.Add("expand_mount_point_postgresql_pgdata", "Расширение точки монтирования")
+		} else {
+			actions.Add("postgresql_expand_mount_point_pg_data", "Расширение точки монтирования")
+		}
+	}
+
+	if plan.Flavor != state.Flavor {
+		if isCluster {
+			actions.AddDisruptive("resize_postgresql_cluster", "Изменение конфигурации ВМ кластера с перезапуском PostgreSQL")
+		} else {
+			actions.AddDisruptive("resize_two_layer", "Изменение конфигурации ВМ с перезапуском PostgreSQL")
+		}
+	}
+
+	for extraMountPath := range plan.ExtraMounts {
+		if _, exists := state.ExtraMounts[extraMountPath]; !exists {
+			actions.Add(
+				addMountPointAction(extraMountPath, isCluster),
+				fmt.Sprintf("Добавление точки монтирования %s", extraMountPath),
+			)
+		}
+	}
+
+	if validateDBs(plan, state.Databases) {
+		actions.Add("postgresql_create_db", "Создание, изменение и удаление баз данных")
+	}
+
+	if validateDBUsers(plan, state.DatabaseUsers) {
+		actions.Add("create_dbms_user", "Создание, изменение и удаление пользователей")
+	}
+	return actions
+}
+
+// addMountPointAction имя действия портала, которое вызывает AddMountPoint для пути
+func addMountPointAction(mountPointPath string, isCluster bool) string {
+	if mountPointPath == "/app/backup" {
+		return "postgresql_cluster_etcd_add_mount_point_app_backup"
+	}
+	suffix := strings.ReplaceAll(strings.TrimPrefix(mountPointPath, "/"), "/", "_")
+	if isCluster {
+		return "postgresql_cluster_add_mount_point_" + suffix
+	}
+	return "postgresql_add_mount_point_" + suffix
+}
+
 func changePostgresqlExtraMounts(
 	order *orders.PostgresqlOrder,
 	planResource *PostgreSQLResourceModel,
@@ -1629,7 +1738,11 @@ func entitiesPostgreSQLDBsToTerraform(
 	dbs := make(map[string]DbModel)
 
 	for _, dbItem := range dbsPortal {
-		db := dbItem.Data.Config.(entities.PostgresqlDbItemConfig)
+		// item с незнакомым config не является БД
+		db, ok := dbItem.Data.Config.(entities.PostgresqlDbItemConfig)
+		if !ok {
+			continue
+		}
 
 		var dbEncoding types.String
 
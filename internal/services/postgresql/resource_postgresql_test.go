@@ -0,0 +1,81 @@
+package postgresql
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-vtb/internal/common"
+	"terraform-provider-vtb/internal/services/flavor"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedActions(t *testing.T) {
+	cases := []struct {
+		name           string
+		productType    string
+		modify         func(plan *PostgreSQLResourceModel)
+		wantNames      []string
+		wantDisruptive bool
+	}{
+		{
+			name:        "no changes",
+			productType: "stand-alone",
+			modify:      func(plan *PostgreSQLResourceModel) {},
+		},
+		{
+			name:        "stand-alone flavor",
+			productType: "stand-alone",
+			modify: func(plan *PostgreSQLResourceModel) {
+				plan.Flavor = flavor.FlavorModel{Name: types.StringValue("c4m8")}
+			},
+			wantNames:      []string{"resize_two_layer"},
+			wantDisruptive: true,
+		},
+		{
+			name:        "cluster flavor and new mount point",
+			productType: "cluster",
+			modify: func(plan *PostgreSQLResourceModel) {
+				plan.Flavor = flavor.FlavorModel{Name: types.StringValue("c4m8")}
+				plan.ExtraMounts = map[string]common.ExtraMountModel{
+					"/pg_data":   {Size: types.Int64Value(50)},
+					"/pg_backup": {Size: types.Int64Value(20)},
+				}
+			},
+			wantNames:      []string{"resize_postgresql_cluster", "postgresql_cluster_add_mount_point_pg_backup"},
+			wantDisruptive: true,
+		},
+		{
+			name:        "new database",
+			productType: "stand-alone",
+			modify: func(plan *PostgreSQLResourceModel) {
+				plan.Databases = map[string]DbModel{"app": {DbAdminPass: types.StringValue("password")}}
+			},
+			wantNames: []string{"postgresql_create_db"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := PostgreSQLResourceModel{
+				Flavor:      flavor.FlavorModel{Name: types.StringValue("c2m4")},
+				Image:       PostgresqlImageDataSourceData{ProductType: types.StringValue(c.productType)},
+				ExtraMounts: map[string]common.ExtraMountModel{"/pg_data": {Size: types.Int64Value(50)}},
+			}
+			plan := state
+			c.modify(&plan)
+
+			actions := plannedActions(&state, &plan)
+			var names []string
+			for _, action := range actions {
+				names = append(names, action.Name)
+			}
+			if !reflect.DeepEqual(names, c.wantNames) {
+				t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+			}
+			if actions.HasDisruptive() != c.wantDisruptive {
+				t.Fatalf("Expected disruptive=%v for %v", c.wantDisruptive, actions)
+			}
+		})
+	}
+}
@@ -44,6 +44,12 @@ var (
 	_ resource.ResourceWithImportState = &RabbitMQClusterResource{}
 )
 
+// rabbitmqItemKinds основной item заказа RabbitMQ, по нему импорт отбирает заказы продукта
+var rabbitmqItemKinds = []entities.ItemKind{
+	{Type: "cluster", Provider: "rabbitmq"},
+	{Type: "cluster", Provider: "rabbitmq_develop"},
+}
+
 type RabbitMQClusterResource struct {
 	client *client.CloudClient
 }
@@ -99,6 +105,7 @@ type RabbitMQClusterModel struct {
 	CertificateExpiration types.String                              `tfsdk:"certificate_expiration"`
 	CertificateValidFrom  types.String                              `tfsdk:"certificate_valid_from"`
 	UpdateMode            types.String                              `tfsdk:"update_product_mode"`
+	PlannedActions        types.List                                `tfsdk:"planned_actions"`
 }
 
 type WebAccessModel struct {
@@ -314,6 +321,7 @@ func (r RabbitMQClusterResource) Schema(
 					stringvalidator.OneOf("latest", "none"),
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -323,7 +331,7 @@ func (r RabbitMQClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(ctx, r.client, path.Root("order_id"), rabbitmqItemKinds, req, resp)
 }
 
 func (r RabbitMQClusterResource) ModifyPlan(
@@ -338,6 +346,9 @@ func (r RabbitMQClusterResource) ModifyPlan(
 	var plan RabbitMQClusterModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	var state RabbitMQClusterModel
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -366,6 +377,12 @@ func (r RabbitMQClusterResource) ModifyPlan(
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r RabbitMQClusterResource) Create(
@@ -430,9 +447,15 @@ func (r RabbitMQClusterResource) Create(
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.BuildVersion = types.StringValue(orderItem.Data.Build.SetupVersion)
-	plan.CertificateCn = types.StringValue(orderItem.Data.Config.(entities.RabbitMQItemConfig).CertificateCn)
-	plan.CertificateExpiration = types.StringValue(orderItem.Data.Config.(entities.RabbitMQItemConfig).CertificateExpiration)
-	plan.CertificateValidFrom = types.StringValue(orderItem.Data.Config.(entities.RabbitMQItemConfig).CertificateValidFrom)
+	config, err := entities.ItemConfig[entities.RabbitMQItemConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	plan.CertificateCn = types.StringValue(config.CertificateCn)
+	plan.CertificateExpiration = types.StringValue(config.CertificateExpiration)
+	plan.CertificateValidFrom = types.StringValue(config.CertificateValidFrom)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -494,7 +517,11 @@ func (r RabbitMQClusterResource) Read(
 		return
 	}
 
-	clusterConfig := cluster.Data.Config.(entities.RabbitMQItemConfig)
+	clusterConfig, err := entities.ItemConfig[entities.RabbitMQItemConfig](cluster)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 	if !ok {
 		resp.Diagnostics.AddError(
 			consts.READ_RES_FAIL,
@@ -586,6 +613,7 @@ func (r RabbitMQClusterResource) Read(
 		CertificateExpiration: types.StringValue(clusterConfig.CertificateExpiration),
 		CertificateValidFrom:  types.StringValue(clusterConfig.CertificateValidFrom),
 		UpdateMode:            types.StringValue("none"),
+		PlannedActions:        utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -609,6 +637,17 @@ func (r RabbitMQClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetRabbitMQOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -834,6 +873,40 @@ func (r RabbitMQClusterResource) prepareAttrs(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *RabbitMQClusterModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if isWebAccessChanged(plan, state) {
+		actions.Add("rabbitmq_edit_access_groups_on_the_web_release", "Изменение групп доступа к web-интерфейсу")
+	}
+
+	if isVerticalScalingNeeded(state, plan) {
+		actions.AddDisruptive("rabbitmq_vertical_scaling_release", "Изменение конфигурации ВМ кластера с перезагрузкой")
+	}
+
+	if !plan.LayoutID.Equal(state.LayoutID) {
+		actions.Add("rabbitmq_scaling_cluster", "Горизонтальное масштабирование кластера")
+	}
+
+	if !plan.RabbitMQVersion.Equal(state.RabbitMQVersion) {
+		actions.AddDisruptive("rabbitmq_upgrade_version", "Обновление версии RabbitMQ с перезапуском кластера")
+	}
+
+	if !plan.UpdateMode.IsNull() && plan.UpdateMode.ValueString() == "latest" {
+		actions.AddDisruptive("rabbitmq_update_version_release", "Обновление версии релиза продукта с перезапуском кластера")
+	}
+	return actions
+}
+
 func (r RabbitMQClusterResource) updateWebAcces(
 	ctx context.Context,
 	order *orders.RabbitMQ,
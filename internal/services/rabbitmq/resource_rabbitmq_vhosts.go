@@ -8,6 +8,7 @@ import (
 
 	"terraform-provider-vtb/internal/client"
 	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/internal/utils"
 	"terraform-provider-vtb/pkg/client/entities"
 	"terraform-provider-vtb/pkg/client/orders"
 
@@ -113,7 +114,7 @@ func (r RabbitMQVhostsResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("rabbitmq_order_id"), req, resp)
+	utils.ImportOrderState(ctx, r.client, path.Root("rabbitmq_order_id"), rabbitmqItemKinds, req, resp)
 }
 
 func (r RabbitMQVhostsResource) Create(
@@ -150,7 +151,11 @@ func (r RabbitMQVhostsResource) Create(
 		return
 	}
 
-	clusterConfig := cluster.Data.Config.(entities.RabbitMQItemConfig)
+	clusterConfig, err := entities.ItemConfig[entities.RabbitMQItemConfig](cluster)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
 	existingVhosts := clusterConfig.Vhosts
 
 	var planVhosts []string
@@ -219,7 +224,11 @@ func (r RabbitMQVhostsResource) Read(
 		return
 	}
 
-	clusterConfig := cluster.Data.Config.(entities.RabbitMQItemConfig)
+	clusterConfig, err := entities.ItemConfig[entities.RabbitMQItemConfig](cluster)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 	existingVhosts := clusterConfig.Vhosts
 
 	var hostnames []string
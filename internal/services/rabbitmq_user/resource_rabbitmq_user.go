@@ -7,6 +7,7 @@ import (
 
 	"terraform-provider-vtb/internal/client"
 	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/internal/utils"
 	"terraform-provider-vtb/pkg/client/entities"
 	"terraform-provider-vtb/pkg/client/orders"
 
@@ -67,17 +68,24 @@ func (r *RabbitMQUserResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 {
+	order, username, ok := utils.SplitCompositeImportID(req.ID)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Invalid import ID format",
-			"Excepted import identifer in the form: `rabbitmq_order_id/username`",
+			"Excepted import identifer in the form: `rabbitmq_order_id/username`, "+
+				"where rabbitmq_order_id can be also `label:<label>` or `cluster_name:<name>`",
 		)
 		return
 	}
 
-	orderID := parts[0]
-	username := parts[1]
+	orderID, diags := utils.ResolveImportOrderID(r.client, order, []entities.ItemKind{
+		{Type: "cluster", Provider: "rabbitmq"},
+		{Type: "cluster", Provider: "rabbitmq_develop"},
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rabbitmq_order_id"), orderID)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("username"), username)...)
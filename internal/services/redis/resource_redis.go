@@ -96,6 +96,8 @@ type RedisResourceModel struct {
 	UserPassword         types.String `tfsdk:"user_password"`
 	NotifyKeyspaceEvents types.String `tfsdk:"notify_keyspace_events"`
 	FinancialProject     types.String `tfsdk:"financial_project"`
+	PowerState           types.String `tfsdk:"power_state"`
+	PlannedActions       types.List   `tfsdk:"planned_actions"`
 }
 
 func (r RedisResource) Schema(
@@ -270,6 +272,8 @@ func (r RedisResource) Schema(
 				Description:         "Источник финансирования.",
 				MarkdownDescription: "Источник финансирования.",
 			},
+			"power_state":     common.PowerStateSchema,
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -279,7 +283,11 @@ func (r RedisResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "redis"}, {Type: "cluster", Provider: "redis"}},
+		req, resp,
+	)
 }
 
 func (r RedisResource) ModifyPlan(
@@ -294,6 +302,9 @@ func (r RedisResource) ModifyPlan(
 	var plan RedisResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkIsOrderDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "app", "redis")
 	resp.Diagnostics.Append(checkIsOrderDeleted.Diagnostics...)
 
@@ -311,6 +322,21 @@ func (r RedisResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsOrderDeleted.IsDeleted {
+		var state RedisResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		validateChangesWhilePoweredOff(&state, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r RedisResource) Create(
@@ -409,6 +435,14 @@ func (r RedisResource) Create(
 		return
 	}
 
+	if plan.PowerState.ValueString() == "off" {
+		resp.Diagnostics.Append(utils.ChangePowerState(order, "off", consts.CREATE_RES_FAIL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.PlannedActions = utils.EmptyPlannedActions()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -602,6 +636,8 @@ func (r RedisResource) Read(
 			},
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PowerState:       utils.ReadPowerState(ctx, appItem.Data.State, req.State),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -625,6 +661,17 @@ func (r RedisResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetRedisOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -659,6 +706,15 @@ func (r RedisResource) Update(
 	financialProjectChanged := !plan.FinancialProject.Equal(state.FinancialProject)
 	userPasswordChanged := state.UserPassword != plan.UserPassword
 	accessChanged := !reflect.DeepEqual(state.Access, plan.Access)
+	powerStateChanged := !plan.PowerState.Equal(state.PowerState)
+
+	// Redis запускается до остальных изменений, а останавливается после них
+	if powerStateChanged && plan.PowerState.ValueString() == "on" {
+		resp.Diagnostics.Append(utils.ChangePowerState(order, "on", consts.UPDATE_RES_FAIL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	if labelChanged {
 		utils.ChangeOrderLabel(order, plan.Label.ValueString(), resp)
@@ -692,6 +748,13 @@ func (r RedisResource) Update(
 		return
 	}
 
+	if powerStateChanged && plan.PowerState.ValueString() == "off" {
+		resp.Diagnostics.Append(utils.ChangePowerState(order, "off", consts.UPDATE_RES_FAIL)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -764,6 +827,72 @@ func (r RedisResource) Delete(
 
 // * Custom logic *
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r RedisResource) plannedActions(state, plan *RedisResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+	powerStateChanged := !plan.PowerState.Equal(state.PowerState)
+
+	if powerStateChanged && plan.PowerState.ValueString() == "on" {
+		actions.Add("start_two_layer", "Запуск Redis")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if plan.NotifyKeyspaceEvents != state.NotifyKeyspaceEvents {
+		actions.Add("change_redis_param_notify", "Изменение параметра notify-keyspace-events")
+	}
+
+	if state.UserPassword != plan.UserPassword {
+		actions.Add("reset_redis_user_password", "Изменение пароля пользователя Redis")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("redis_resize_two_layer", "Изменение конфигурации ВМ с перезапуском Redis")
+	}
+
+	if !reflect.DeepEqual(state.Access, plan.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if powerStateChanged && plan.PowerState.ValueString() == "off" {
+		actions.AddDisruptive("stop_two_layer", "Остановка Redis")
+	}
+	return actions
+}
+
+// validateChangesWhilePoweredOff запрещает изменения, которые применяются в конфигурации
+// запущенного Redis. Если Redis запускается в том же плане, изменения допустимы
+func validateChangesWhilePoweredOff(state, plan *RedisResourceModel, diags *diag.Diagnostics) {
+	if state.PowerState.ValueString() != "off" || plan.PowerState.ValueString() != "off" {
+		return
+	}
+
+	if state.UserPassword != plan.UserPassword {
+		diags.AddAttributeError(
+			path.Root("user_password"),
+			consts.MODIFY_PLAN_FAIL,
+			"Redis is stopped, user password can't be changed. Set power_state to 'on' to change it.",
+		)
+	}
+	if plan.NotifyKeyspaceEvents != state.NotifyKeyspaceEvents {
+		diags.AddAttributeError(
+			path.Root("notify_keyspace_events"),
+			consts.MODIFY_PLAN_FAIL,
+			"Redis is stopped, notify_keyspace_events can't be changed. Set power_state to 'on' to change it.",
+		)
+	}
+}
+
 func (r RedisResource) changeRedisExtraMounts(
 	order *orders.Redis,
 	plan *RedisResourceModel,
@@ -0,0 +1,139 @@
+package redis
+
+import (
+	"reflect"
+	"testing"
+
+	"terraform-provider-vtb/internal/services/flavor"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedActions(t *testing.T) {
+	cases := []struct {
+		name           string
+		statePower     string
+		modify         func(plan *RedisResourceModel)
+		wantNames      []string
+		wantDisruptive bool
+	}{
+		{
+			name:       "no changes",
+			statePower: "on",
+			modify:     func(plan *RedisResourceModel) {},
+			wantNames:  nil,
+		},
+		{
+			name:       "flavor and password",
+			statePower: "on",
+			modify: func(plan *RedisResourceModel) {
+				plan.Flavor = flavor.FlavorModel{Name: types.StringValue("c4m8")}
+				plan.UserPassword = types.StringValue("new-password")
+			},
+			wantNames:      []string{"reset_redis_user_password", "redis_resize_two_layer"},
+			wantDisruptive: true,
+		},
+		{
+			name:       "start before changes",
+			statePower: "off",
+			modify: func(plan *RedisResourceModel) {
+				plan.PowerState = types.StringValue("on")
+				plan.NotifyKeyspaceEvents = types.StringValue("KEA")
+			},
+			wantNames: []string{"start_two_layer", "change_redis_param_notify"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := RedisResourceModel{
+				Flavor:       flavor.FlavorModel{Name: types.StringValue("c2m4")},
+				UserPassword: types.StringValue("password"),
+				PowerState:   types.StringValue(c.statePower),
+			}
+			plan := state
+			c.modify(&plan)
+
+			actions := RedisResource{}.plannedActions(&state, &plan)
+			var names []string
+			for _, action := range actions {
+				names = append(names, action.Name)
+			}
+			if !reflect.DeepEqual(names, c.wantNames) {
+				t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+			}
+			if actions.HasDisruptive() != c.wantDisruptive {
+				t.Fatalf("Expected disruptive=%v for %v", c.wantDisruptive, actions)
+			}
+		})
+	}
+}
+
+func TestValidateChangesWhilePoweredOff(t *testing.T) {
+	cases := []struct {
+		name       string
+		statePower string
+		modify     func(plan *RedisResourceModel)
+		wantErrors []string
+	}{
+		{
+			name:       "running redis",
+			statePower: "on",
+			modify: func(plan *RedisResourceModel) {
+				plan.UserPassword = types.StringValue("new-password")
+				plan.NotifyKeyspaceEvents = types.StringValue("KEA")
+			},
+		},
+		{
+			name:       "stopped redis",
+			statePower: "off",
+			modify: func(plan *RedisResourceModel) {
+				plan.UserPassword = types.StringValue("new-password")
+				plan.NotifyKeyspaceEvents = types.StringValue("KEA")
+				plan.Flavor = flavor.FlavorModel{Name: types.StringValue("c4m8")}
+			},
+			wantErrors: []string{"user_password", "notify_keyspace_events"},
+		},
+		{
+			name:       "started in the same plan",
+			statePower: "off",
+			modify: func(plan *RedisResourceModel) {
+				plan.PowerState = types.StringValue("on")
+				plan.UserPassword = types.StringValue("new-password")
+			},
+		},
+		{
+			name:       "stopped in the same plan",
+			statePower: "on",
+			modify: func(plan *RedisResourceModel) {
+				plan.PowerState = types.StringValue("off")
+				plan.NotifyKeyspaceEvents = types.StringValue("KEA")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := RedisResourceModel{
+				Flavor:               flavor.FlavorModel{Name: types.StringValue("c2m4")},
+				UserPassword:         types.StringValue("password"),
+				NotifyKeyspaceEvents: types.StringValue(""),
+				PowerState:           types.StringValue(c.statePower),
+			}
+			plan := state
+			c.modify(&plan)
+
+			var diags diag.Diagnostics
+			validateChangesWhilePoweredOff(&state, &plan, &diags)
+
+			var attrs []string
+			for _, d := range diags.Errors() {
+				attrs = append(attrs, d.(diag.DiagnosticWithPath).Path().String())
+			}
+			if !reflect.DeepEqual(attrs, c.wantErrors) {
+				t.Fatalf("Expected errors for %v, got %v: %v", c.wantErrors, attrs, diags)
+			}
+		})
+	}
+}
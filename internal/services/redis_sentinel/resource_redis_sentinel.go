@@ -93,6 +93,7 @@ type RedisSentinelResourceModel struct {
 	UserPassword         types.String `tfsdk:"user_password"`
 	NotifyKeyspaceEvents types.String `tfsdk:"notify_keyspace_events"`
 	FinancialProject     types.String `tfsdk:"financial_project"`
+	PlannedActions       types.List   `tfsdk:"planned_actions"`
 }
 
 func (r RedisSentinelResource) Schema(
@@ -232,6 +233,7 @@ func (r RedisSentinelResource) Schema(
 				Description:         "Источник финансирования.",
 				MarkdownDescription: "Источник финансирования.",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -241,7 +243,11 @@ func (r RedisSentinelResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "redis_sentinel"}},
+		req, resp,
+	)
 }
 
 func (r RedisSentinelResource) ModifyPlan(
@@ -256,6 +262,9 @@ func (r RedisSentinelResource) ModifyPlan(
 	var plan RedisSentinelResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkIsOrderDeleted := utils.CheckOrderIsDeleted(r.client, plan.OrderID, "app", "redis_sentinel")
 	resp.Diagnostics.Append(checkIsOrderDeleted.Diagnostics...)
 
@@ -273,6 +282,17 @@ func (r RedisSentinelResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsOrderDeleted.IsDeleted {
+		var state RedisSentinelResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r RedisSentinelResource) Create(
@@ -349,11 +369,15 @@ func (r RedisSentinelResource) Create(
 		return
 	}
 
-	itemData := vmItems[0].Data
-
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
-	plan.Hostname = types.StringValue(itemData.Config.(entities.VMItemConfig).Hostname)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	plan.Hostname = types.StringValue(vmConfig.Hostname)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -492,6 +516,7 @@ func (r RedisSentinelResource) Read(
 			Zone:           types.StringValue(order.Attrs.AvailabilityZone),
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -515,6 +540,17 @@ func (r RedisSentinelResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetRedisSentinelOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -702,6 +738,36 @@ func (r RedisSentinelResource) prepareAttrs(plan RedisSentinelResourceModel) (at
 	return attrs, diags
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *RedisSentinelResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if state.UserPassword != plan.UserPassword {
+		actions.Add("reset_sentinel_redis_user_password", "Изменение пароля пользователя Redis")
+	}
+
+	if plan.NotifyKeyspaceEvents != state.NotifyKeyspaceEvents {
+		actions.Add("change_redis_sentinel_param_notify", "Изменение параметра notify-keyspace-events")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("redis_sentinel_resize_two_layer", "Изменение конфигурации ВМ с перезапуском Redis")
+	}
+
+	if !reflect.DeepEqual(state.Access, plan.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+	return actions
+}
+
 func (r RedisSentinelResource) changeFlavorRedisSentinel(
 	order *orders.RedisSentinel,
 	plan *RedisSentinelResourceModel,
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
 	"terraform-provider-vtb/internal/consts"
 	"terraform-provider-vtb/internal/utils"
 	"terraform-provider-vtb/pkg/client/entities"
@@ -77,6 +78,7 @@ type RQaaSResourceModel struct {
 	ItemID           types.String                `tfsdk:"item_id"`
 	FinancialProject types.String                `tfsdk:"financial_project"`
 	QueueUsers       types.Set                   `tfsdk:"queue_users"`
+	PlannedActions   types.List                  `tfsdk:"planned_actions"`
 }
 
 type QueueUserModel struct {
@@ -215,6 +217,7 @@ func (r RQaaSResource) Schema(
 					Attributes: queueUserSchema,
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -224,7 +227,11 @@ func (r RQaaSResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "saas", Provider: "rqaas"}},
+		req, resp,
+	)
 }
 
 func (r RQaaSResource) ModifyPlan(
@@ -239,6 +246,9 @@ func (r RQaaSResource) ModifyPlan(
 	var plan RQaaSResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(
 		r.client,
 		plan.OrderID,
@@ -272,6 +282,26 @@ func (r RQaaSResource) ModifyPlan(
 	}
 
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state RQaaSResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var diags diag.Diagnostics
+		actions, diags = r.plannedActions(ctx, &state, &plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r RQaaSResource) Create(
@@ -375,6 +405,7 @@ func (r RQaaSResource) Create(
 	}
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(parentItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -445,7 +476,8 @@ func (r RQaaSResource) Read(
 			Zone:       types.StringValue(order.Attrs.Cluster.AvailabilityZone),
 			Hosts:      tfhosts,
 		},
-		QueueUsers: tfQueueUsers,
+		QueueUsers:     tfQueueUsers,
+		PlannedActions: utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -468,6 +500,17 @@ func (r RQaaSResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	actions, diags := r.plannedActions(ctx, &state, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(ctx, r.client, req.Plan, actions)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetRQaaSOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -655,6 +698,35 @@ func (r RQaaSResource) compareQueueUsers(
 	return toAdd, toUpdate, toDelete, diags
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r RQaaSResource) plannedActions(
+	ctx context.Context,
+	state, plan *RQaaSResourceModel,
+) (actions utils.PlannedActions, diags diag.Diagnostics) {
+	if !plan.Label.Equal(state.Label) {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.QueueUsers.Equal(state.QueueUsers) {
+		toAdd, toUpdate, toDelete, compareDiags := r.compareQueueUsers(ctx, plan.QueueUsers, state.QueueUsers)
+		diags.Append(compareDiags...)
+		if diags.HasError() {
+			return actions, diags
+		}
+		if len(toAdd) > 0 {
+			actions.Add("rqaas_user_add", "Добавление пользователей очереди")
+		}
+		if len(toUpdate) > 0 || len(toDelete) > 0 {
+			actions.Add("rqaas_edit_access", "Изменение прав пользователей очереди")
+		}
+	}
+	return actions, diags
+}
+
 func (r RQaaSResource) applyQueueUsers(
 	ctx context.Context,
 	order *orders.RQaaS,
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
 	"terraform-provider-vtb/internal/consts"
 	"terraform-provider-vtb/internal/utils"
 	"terraform-provider-vtb/pkg/client/entities"
@@ -96,6 +97,7 @@ type S3CephResourceModel struct {
 	Buckets          map[string]BucketModel    `tfsdk:"buckets"`
 	Users            map[string]S3UserModel    `tfsdk:"users"`
 	FinancialProject types.String              `tfsdk:"financial_project"`
+	PlannedActions   types.List                `tfsdk:"planned_actions"`
 }
 
 func (r S3CephResource) Schema(
@@ -259,6 +261,7 @@ func (r S3CephResource) Schema(
 				Description:         "Источник финансирования.",
 				MarkdownDescription: "Источник финансирования.",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -268,7 +271,11 @@ func (r S3CephResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "s3", Provider: "ceph"}},
+		req, resp,
+	)
 }
 
 func (r S3CephResource) ModifyPlan(
@@ -284,6 +291,9 @@ func (r S3CephResource) ModifyPlan(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -324,6 +334,15 @@ func (r S3CephResource) ModifyPlan(
 		return
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r S3CephResource) Create(
@@ -420,12 +439,18 @@ func (r S3CephResource) Create(
 		return
 	}
 
-	userEndpoint := orderItem.Data.Config.(entities.S3CephTenantItemConfig).UserEndpoint
-	mtlsEndpoint := orderItem.Data.Config.(entities.S3CephTenantItemConfig).MtlsEndpoint
+	config, err := entities.ItemConfig[entities.S3CephTenantItemConfig](orderItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
+	userEndpoint := config.UserEndpoint
+	mtlsEndpoint := config.MtlsEndpoint
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.UserEndpoint = types.StringValue(userEndpoint)
 	plan.MtlsEndpoint = types.StringValue(mtlsEndpoint)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -523,6 +548,7 @@ func (r S3CephResource) Read(
 	data.Zone = types.StringValue(order.Attrs.AvailabilityZone)
 	data.NetSegment = types.StringValue(order.Attrs.NetSegment)
 	data.FinancialProject = types.StringValue(order.FinancialSource.Name)
+	data.PlannedActions = utils.PriorPlannedActions(ctx, req.State)
 
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
@@ -548,6 +574,20 @@ func (r S3CephResource) Update(
 	resp.Diagnostics.Append(diags...)
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	order, err := orders.GetS3CephOrder(
 		r.client.Creds,
@@ -705,6 +745,76 @@ func (r S3CephResource) Delete(
 
 // * Custom logic*
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *S3CephResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.FinancialProject != state.FinancialProject {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	var bucketsToCreate, bucketsToUpdate, bucketsToDelete bool
+	for name, bucket := range plan.Buckets {
+		bucketState, exist := state.Buckets[name]
+		if !exist {
+			bucketsToCreate = true
+			continue
+		}
+		if bucket.MaxSizeGb.ValueInt64() != bucketState.MaxSizeGb.ValueInt64() ||
+			bucket.Versioning.ValueBool() != bucketState.Versioning.ValueBool() {
+			bucketsToUpdate = true
+		}
+	}
+	for name := range state.Buckets {
+		if _, exist := plan.Buckets[name]; !exist {
+			bucketsToDelete = true
+		}
+	}
+	if bucketsToCreate {
+		actions.Add("s3_ceph_bucket_add", "Создание бакетов")
+	}
+	if bucketsToUpdate {
+		actions.Add("s3_ceph_bucket_update", "Изменение параметров бакетов")
+	}
+	if bucketsToDelete {
+		actions.Add("s3_ceph_bucket_delete", "Удаление бакетов")
+	}
+
+	var usersToCreate, usersToUpdate, usersToDelete bool
+	for userName, user := range plan.Users {
+		userState, exist := state.Users[userName]
+		if !exist {
+			usersToCreate = true
+			continue
+		}
+		if user.AccessKey.ValueString() != userState.AccessKey.ValueString() {
+			usersToDelete = true
+			usersToCreate = true
+		} else if user.SecretKey.ValueString() != userState.SecretKey.ValueString() {
+			usersToUpdate = true
+		}
+	}
+	for userName := range state.Users {
+		if _, exist := plan.Users[userName]; !exist {
+			usersToDelete = true
+		}
+	}
+	if usersToDelete {
+		actions.Add("s3_ceph_user_delete", "Удаление пользователей")
+	}
+	if usersToCreate {
+		actions.Add("s3_ceph_user_add", "Создание пользователей")
+	}
+	if usersToUpdate {
+		actions.Add("s3_ceph_regenerate_keys", "Изменение ключей пользователей")
+	}
+	return actions
+}
+
 func configureBuckets(
 	state,
 	plan *S3CephResourceModel,
@@ -816,7 +926,11 @@ func entitiesS3CephBucketsToTerraform(
 	buckets := make(map[string]BucketModel)
 
 	for _, bucketItem := range bucketsPortal {
-		bucket := bucketItem.Data.Config.(entities.S3CephBucketItemConfig)
+		// item с незнакомым config не является бакетом
+		bucket, ok := bucketItem.Data.Config.(entities.S3CephBucketItemConfig)
+		if !ok {
+			continue
+		}
 		if _, exist := bucketsState[bucket.Name]; exist {
 			buckets[bucket.Name] = bucketsState[bucket.Name]
 		} else {
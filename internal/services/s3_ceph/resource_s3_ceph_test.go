@@ -0,0 +1,77 @@
+package s3ceph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPlannedActions(t *testing.T) {
+	cases := []struct {
+		name      string
+		modify    func(plan *S3CephResourceModel)
+		wantNames []string
+	}{
+		{
+			name:      "no changes",
+			modify:    func(plan *S3CephResourceModel) {},
+			wantNames: nil,
+		},
+		{
+			name: "buckets",
+			modify: func(plan *S3CephResourceModel) {
+				plan.Buckets = map[string]BucketModel{
+					"bucket-a": {Versioning: types.BoolValue(true), MaxSizeGb: types.Int64Value(10)},
+					"bucket-c": {Versioning: types.BoolValue(false), MaxSizeGb: types.Int64Value(5)},
+				}
+			},
+			wantNames: []string{"s3_ceph_bucket_add", "s3_ceph_bucket_update", "s3_ceph_bucket_delete"},
+		},
+		{
+			name: "access key recreates user",
+			modify: func(plan *S3CephResourceModel) {
+				plan.Users = map[string]S3UserModel{
+					"user-a": {AccessKey: types.StringValue("NEWACCESSKEY"), SecretKey: types.StringValue("secret")},
+				}
+			},
+			wantNames: []string{"s3_ceph_user_delete", "s3_ceph_user_add"},
+		},
+		{
+			name: "secret key and label",
+			modify: func(plan *S3CephResourceModel) {
+				plan.Label = types.StringValue("new-label")
+				plan.Users = map[string]S3UserModel{
+					"user-a": {AccessKey: types.StringValue("ACCESSKEY"), SecretKey: types.StringValue("new-secret")},
+				}
+			},
+			wantNames: []string{"change_label", "s3_ceph_regenerate_keys"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			state := S3CephResourceModel{
+				Label:            types.StringValue("label"),
+				FinancialProject: types.StringValue("finproj"),
+				Buckets: map[string]BucketModel{
+					"bucket-a": {Versioning: types.BoolValue(false), MaxSizeGb: types.Int64Value(10)},
+					"bucket-b": {Versioning: types.BoolValue(false), MaxSizeGb: types.Int64Value(10)},
+				},
+				Users: map[string]S3UserModel{
+					"user-a": {AccessKey: types.StringValue("ACCESSKEY"), SecretKey: types.StringValue("secret")},
+				},
+			}
+			plan := state
+			c.modify(&plan)
+
+			var names []string
+			for _, action := range plannedActions(&state, &plan) {
+				names = append(names, action.Name)
+			}
+			if !reflect.DeepEqual(names, c.wantNames) {
+				t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+			}
+		})
+	}
+}
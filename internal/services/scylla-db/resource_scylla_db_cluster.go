@@ -99,6 +99,7 @@ type ScyllaDbClusterResourceModel struct {
 	DbUsers                    map[string]ScyllaDbUsersModel     `tfsdk:"db_users"`
 	DbPermissions              types.Set                         `tfsdk:"db_permissions"`
 	ScyllaClusterConfiguration ScyllaClusterConfigurationModel   `tfsdk:"scylla_cluster_configuration"`
+	PlannedActions             types.List                        `tfsdk:"planned_actions"`
 }
 
 type ScyllaClusterConfigurationModel struct {
@@ -365,6 +366,7 @@ func (r ScyllaDbClusterResource) Schema(
 					},
 				},
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -374,7 +376,11 @@ func (r ScyllaDbClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "scylladb"}},
+		req, resp,
+	)
 }
 
 func (r ScyllaDbClusterResource) ModifyPlan(
@@ -390,6 +396,9 @@ func (r ScyllaDbClusterResource) ModifyPlan(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkIsDeletedOrder := utils.CheckOrderIsDeleted(
 		r.client,
 		plan.OrderID,
@@ -422,6 +431,17 @@ func (r ScyllaDbClusterResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsDeletedOrder.IsDeleted {
+		var state ScyllaDbClusterResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r ScyllaDbClusterResource) Create(
@@ -515,6 +535,7 @@ func (r ScyllaDbClusterResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -684,6 +705,7 @@ func (r ScyllaDbClusterResource) Read(
 			DC2: types.Int64Value(int64(order.Attrs.ScyllaClusterConfiguration.DC2)),
 			DC3: types.Int64Value(int64(order.Attrs.ScyllaClusterConfiguration.DC3)),
 		},
+		PlannedActions: utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -718,6 +740,17 @@ func (r ScyllaDbClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetScyllaDbClusterOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -1032,6 +1065,52 @@ func (r ScyllaDbClusterResource) compareADRoles(planAccess, stateAccess map[stri
 	return deletedRoles, changedRoles, addedRoles
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *ScyllaDbClusterResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("scylladb_cluster_vertical_scaling", "Изменение конфигурации ВМ кластера с перезагрузкой")
+	}
+
+	if !reflect.DeepEqual(state.Access, plan.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if !reflect.DeepEqual(plan.Databases, state.Databases) {
+		deletedDbs, addedDbs := compareScyllaDbClusterDbs(state, plan)
+		if len(deletedDbs) > 0 {
+			actions.Add("scylladb_remove_db", "Удаление баз данных")
+		}
+		if len(addedDbs) > 0 {
+			actions.Add("scylladb_create_db", "Создание баз данных")
+		}
+	}
+
+	if !reflect.DeepEqual(plan.DbUsers, state.DbUsers) {
+		actions.Add("scylladb_create_dbms_user", "Создание, изменение и удаление пользователей")
+	}
+
+	if !reflect.DeepEqual(plan.DbPermissions, state.DbPermissions) {
+		deletedDbPermissions, addedDbPermissions := compareScyllaDbClusterDbPermissions(state, plan)
+		if len(deletedDbPermissions) > 0 {
+			actions.Add("scylladb_remove_dbms_permissions", "Удаление прав пользователей на базы данных")
+		}
+		if len(addedDbPermissions) > 0 {
+			actions.Add("scylladb_dbms_permissions", "Выдача прав пользователям на базы данных")
+		}
+	}
+	return actions
+}
+
 func changeScyllaDbClusterDbs(
 	order *orders.ScyllaDbCluster,
 	state,
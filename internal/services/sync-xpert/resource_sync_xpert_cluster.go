@@ -94,6 +94,7 @@ type SyncXpertClusterResourceModel struct {
 	ClusterGroupID  types.String `tfsdk:"cluster_group_id"`
 
 	FinancialProject types.String `tfsdk:"financial_project"`
+	PlannedActions   types.List   `tfsdk:"planned_actions"`
 }
 
 func (r *SyncXpertClusterResource) Schema(
@@ -293,6 +294,7 @@ func (r *SyncXpertClusterResource) Schema(
 				Description:         "Источник финансирования",
 				MarkdownDescription: "Источник финансирования",
 			},
+			"planned_actions": common.PlannedActionsSchema,
 		},
 	}
 }
@@ -309,6 +311,9 @@ func (r *SyncXpertClusterResource) ModifyPlan(
 	var plan SyncXpertClusterResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(
 		r.client,
 		plan.OrderID,
@@ -336,6 +341,16 @@ func (r *SyncXpertClusterResource) ModifyPlan(
 		return
 	}
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state SyncXpertClusterResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r *SyncXpertClusterResource) Create(
@@ -433,6 +448,7 @@ func (r *SyncXpertClusterResource) Create(
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(orderItem.ID)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -555,6 +571,7 @@ func (r *SyncXpertClusterResource) Read(
 		ClusterName:     types.StringValue(clusterConfig.ClusterName),
 		ClusterGroupID:  types.StringValue(order.Attrs.DebeziumConfig.ClusterGroupID),
 		APIPassword:     apiPassword,
+		PlannedActions:  utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	var lifetime types.Int64
@@ -578,6 +595,17 @@ func (r *SyncXpertClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetDebeziumOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -702,6 +730,32 @@ func (r *SyncXpertClusterResource) Delete(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *SyncXpertClusterResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("debezium_vertical_scaling", "Изменение конфигурации ВМ кластера с перезагрузкой")
+	}
+
+	if !reflect.DeepEqual(plan.Access, state.Access) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+	return actions
+}
+
 func (r *SyncXpertClusterResource) verticalScaling(
 	order *orders.SyncXpertCluster,
 	plan *SyncXpertClusterResourceModel,
@@ -3,6 +3,7 @@ package tarantool
 import (
 	"context"
 	"fmt"
+	"sort"
 	"terraform-provider-vtb/internal/client"
 	"terraform-provider-vtb/internal/common"
 	"terraform-provider-vtb/internal/consts"
@@ -71,20 +72,23 @@ func (r *TarantoolClusterResource) Configure(ctx context.Context, req resource.C
 }
 
 type TarantoolClusterResourceModel struct {
-	Lifetime              types.Int64                `tfsdk:"lifetime"`
-	Label                 types.String               `tfsdk:"label"`
-	OrderID               types.String               `tfsdk:"order_id"`
-	ItemID                types.String               `tfsdk:"item_id"`
-	Core                  core.CoreModel             `tfsdk:"core"`
-	Image                 TarantoolClusterImageModel `tfsdk:"image"`
-	Layout                types.String               `tfsdk:"layout"`
-	TarantoolAccessGroup  types.Set                  `tfsdk:"tarantool_access"`
-	ActiveDirectoryAccess map[string][]string        `tfsdk:"access"`
-	TarantoolVersion      types.String               `tfsdk:"tarantool_version"`
-	FinancialProject      types.String               `tfsdk:"financial_project"`
-	ClusterName           types.String               `tfsdk:"cluster_name"`
-	TarantoolType         types.String               `tfsdk:"tarantool_type"`
-	Zones                 types.Map                  `tfsdk:"zones"`
+	Lifetime              types.Int64                    `tfsdk:"lifetime"`
+	Label                 types.String                   `tfsdk:"label"`
+	OrderID               types.String                   `tfsdk:"order_id"`
+	ItemID                types.String                   `tfsdk:"item_id"`
+	Core                  core.CoreModel                 `tfsdk:"core"`
+	Image                 TarantoolClusterImageModel     `tfsdk:"image"`
+	Layout                types.String                   `tfsdk:"layout"`
+	TarantoolAccessGroup  types.Set                      `tfsdk:"tarantool_access"`
+	ActiveDirectoryAccess map[string][]string            `tfsdk:"access"`
+	TarantoolVersion      types.String                   `tfsdk:"tarantool_version"`
+	FinancialProject      types.String                   `tfsdk:"financial_project"`
+	ClusterName           types.String                   `tfsdk:"cluster_name"`
+	TarantoolType         types.String                   `tfsdk:"tarantool_type"`
+	Zones                 types.Map                      `tfsdk:"zones"`
+	PlannedActions        types.List                     `tfsdk:"planned_actions"`
+	MaintenanceWindow     *common.MaintenanceWindowModel `tfsdk:"maintenance_window"`
+	MaintenanceOverride   types.Bool                     `tfsdk:"maintenance_override"`
 }
 
 // Модель для отображения данных о зонах и их инстансах
@@ -283,6 +287,9 @@ func (r TarantoolClusterResource) Schema(
 					},
 				},
 			},
+			"planned_actions":      common.PlannedActionsSchema,
+			"maintenance_window":   common.MaintenanceWindowSchema,
+			"maintenance_override": common.MaintenanceOverrideSchema,
 		},
 	}
 }
@@ -292,7 +299,11 @@ func (r TarantoolClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "cluster", Provider: "tarantool_v2"}},
+		req, resp,
+	)
 }
 
 func (r TarantoolClusterResource) ModifyPlan(
@@ -307,6 +318,9 @@ func (r TarantoolClusterResource) ModifyPlan(
 	var plan TarantoolClusterResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(
 		r.client,
 		plan.OrderID,
@@ -332,6 +346,20 @@ func (r TarantoolClusterResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		var state TarantoolClusterResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		actions = r.plannedActions(ctx, &state, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r TarantoolClusterResource) Create(
@@ -408,6 +436,7 @@ func (r TarantoolClusterResource) Create(
 	plan.ItemID = types.StringValue(orderItem.ID)
 	plan.ClusterName = types.StringValue(clusterConfig.ClusterName)
 	plan.TarantoolType = types.StringValue(clusterConfig.TarantoolType)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -512,8 +541,9 @@ func (r TarantoolClusterResource) Read(
 			GeoDistribution:         types.BoolValue(order.Attrs.GeoDistribution),
 			DefaultTarantoolVersion: types.StringValue(clusterConfig.TarantoolVersion),
 		},
-		ClusterName:   types.StringValue(clusterConfig.ClusterName),
-		TarantoolType: types.StringValue(clusterConfig.TarantoolType),
+		ClusterName:    types.StringValue(clusterConfig.ClusterName),
+		TarantoolType:  types.StringValue(clusterConfig.TarantoolType),
+		PlannedActions: utils.PriorPlannedActions(ctx, req.State),
 	}
 	state.Zones, diags = types.MapValueFrom(
 		ctx,
@@ -524,6 +554,9 @@ func (r TarantoolClusterResource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_window"), &state.MaintenanceWindow)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_override"), &state.MaintenanceOverride)...)
+
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
 	if !lifetime.IsNull() {
@@ -545,6 +578,17 @@ func (r TarantoolClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(ctx, &state, &plan, &resp.Diagnostics),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetTarantoolClusterOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -907,6 +951,78 @@ func (r TarantoolClusterResource) compareZoneChanges(
 	return toRunning, toStopped
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r TarantoolClusterResource) plannedActions(
+	ctx context.Context,
+	state, plan *TarantoolClusterResourceModel,
+	diags *diag.Diagnostics,
+) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if !plan.Label.Equal(state.Label) {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.Zones.IsNull() && !plan.Zones.IsUnknown() && !plan.Zones.Equal(state.Zones) {
+		planZones := make(map[string]ZoneConfigModel)
+		diags.Append(plan.Zones.ElementsAs(ctx, &planZones, false)...)
+
+		stateZones := make(map[string]ZoneConfigModel)
+		if !state.Zones.IsNull() {
+			diags.Append(state.Zones.ElementsAs(ctx, &stateZones, false)...)
+		}
+		if diags.HasError() {
+			return nil
+		}
+
+		zoneNames := make([]string, 0, len(planZones))
+		for zoneName := range planZones {
+			zoneNames = append(zoneNames, zoneName)
+		}
+		sort.Strings(zoneNames)
+
+		for _, zoneName := range zoneNames {
+			stateZone, exists := stateZones[zoneName]
+			if !exists {
+				continue
+			}
+
+			toEnable, toDisable := r.compareZoneChanges(ctx, planZones[zoneName], stateZone, diags)
+			if diags.HasError() {
+				return nil
+			}
+
+			zoneNum, err := utils.ExtractZoneNumber(zoneName)
+			if err != nil {
+				continue
+			}
+
+			if len(toEnable) > 0 {
+				actions.Add(
+					fmt.Sprintf("tarantool_v2_start_instances_zone-%v", zoneNum),
+					fmt.Sprintf("Запуск инстансов в зоне %s: %v", zoneName, toEnable),
+				)
+			}
+
+			if len(toDisable) > 0 {
+				actions.AddDisruptive(
+					fmt.Sprintf("tarantool_v2_stop_instances_zone-%v", zoneNum),
+					fmt.Sprintf("Остановка инстансов в зоне %s: %v", zoneName, toDisable),
+				)
+			}
+		}
+	}
+
+	if !plan.TarantoolVersion.Equal(state.TarantoolVersion) {
+		actions.AddDisruptive("tarantool_v2_update", "Обновление версии Tarantool с перезапуском кластера")
+	}
+	return actions
+}
+
 func (r TarantoolClusterResource) updateTarantoolVersion(
 	plan *TarantoolClusterResourceModel,
 	order *orders.TarantoolCluster,
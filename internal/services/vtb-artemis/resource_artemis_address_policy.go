@@ -249,7 +249,7 @@ func (r ArtemisAddressPolicyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("vtb_artemis_order_id"), req, resp)
+	utils.ImportOrderState(ctx, r.client, path.Root("vtb_artemis_order_id"), artemisItemKinds, req, resp)
 }
 
 func (r ArtemisAddressPolicyResource) Create(
@@ -331,7 +331,12 @@ func (r ArtemisAddressPolicyResource) Read(
 		return
 	}
 
-	addrPolicies := parentItem.Data.Config.(entities.VTBArtemisItemConfig).AddressPolicyList
+	config, err := entities.ItemConfig[entities.VTBArtemisItemConfig](parentItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	addrPolicies := config.AddressPolicyList
 
 	state := &ArtemisAddressPolicyListModel{
 		OrderID:           orderID,
@@ -44,6 +44,9 @@ var (
 	_ resource.ResourceWithModifyPlan  = &ArtemisClusterResource{}
 )
 
+// artemisItemKinds основной item заказа VTB Artemis, по нему импорт отбирает заказы продукта
+var artemisItemKinds = []entities.ItemKind{{Type: "cluster", Provider: "vtb-artemis"}}
+
 type ArtemisClusterResource struct {
 	client *client.CloudClient
 }
@@ -90,15 +93,18 @@ type ArtemisClusterResourceModel struct {
 	Access      map[string][]types.String                 `tfsdk:"access"`
 	ExtraMounts map[string]common.ExtraMountModel         `tfsdk:"extra_mounts"`
 
-	ClusterName      types.String `tfsdk:"cluster_name"`
-	BuildVersion     types.String `tfsdk:"build_version"`
-	LayoutID         types.String `tfsdk:"layout_id"`
-	ProtocolCore     types.Bool   `tfsdk:"protocol_core"`
-	ProtocolAMQP     types.Bool   `tfsdk:"protocol_amqp"`
-	ArtemisVersion   types.String `tfsdk:"artemis_version"`
-	FinancialProject types.String `tfsdk:"financial_project"`
-	UpdateMode       types.String `tfsdk:"update_product_mode"`
-	Plugins          PluginsModel `tfsdk:"plugins"`
+	ClusterName         types.String                   `tfsdk:"cluster_name"`
+	BuildVersion        types.String                   `tfsdk:"build_version"`
+	LayoutID            types.String                   `tfsdk:"layout_id"`
+	ProtocolCore        types.Bool                     `tfsdk:"protocol_core"`
+	ProtocolAMQP        types.Bool                     `tfsdk:"protocol_amqp"`
+	ArtemisVersion      types.String                   `tfsdk:"artemis_version"`
+	FinancialProject    types.String                   `tfsdk:"financial_project"`
+	UpdateMode          types.String                   `tfsdk:"update_product_mode"`
+	Plugins             PluginsModel                   `tfsdk:"plugins"`
+	PlannedActions      types.List                     `tfsdk:"planned_actions"`
+	MaintenanceWindow   *common.MaintenanceWindowModel `tfsdk:"maintenance_window"`
+	MaintenanceOverride types.Bool                     `tfsdk:"maintenance_override"`
 }
 
 type PluginsModel struct {
@@ -360,6 +366,9 @@ func (r ArtemisClusterResource) Schema(
 					stringvalidator.OneOf("latest", "none"),
 				},
 			},
+			"planned_actions":      common.PlannedActionsSchema,
+			"maintenance_window":   common.MaintenanceWindowSchema,
+			"maintenance_override": common.MaintenanceOverrideSchema,
 		},
 	}
 }
@@ -369,7 +378,7 @@ func (r ArtemisClusterResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(ctx, r.client, path.Root("order_id"), artemisItemKinds, req, resp)
 }
 
 func (r ArtemisClusterResource) ModifyPlan(
@@ -384,6 +393,9 @@ func (r ArtemisClusterResource) ModifyPlan(
 	var plan, state ArtemisClusterResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	if !req.State.Raw.IsNull() {
 		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	}
@@ -447,6 +459,11 @@ func (r ArtemisClusterResource) ModifyPlan(
 	}
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkIsOrderDeleted.IsDeleted {
+		actions = r.plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r ArtemisClusterResource) Create(
@@ -532,6 +549,7 @@ func (r ArtemisClusterResource) Create(
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(artemisItem.ID)
 	plan.BuildVersion = types.StringValue(artemisItem.Data.Build.SetupVersion)
+	plan.PlannedActions = utils.EmptyPlannedActions()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -579,7 +597,11 @@ func (r ArtemisClusterResource) Read(
 		)
 		return
 	}
-	clusterConfig := clusterItem.Data.Config.(entities.VTBArtemisItemConfig)
+	clusterConfig, err := entities.ItemConfig[entities.VTBArtemisItemConfig](clusterItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	vmItems, err := order.GetVMItems()
 	if err != nil {
@@ -590,7 +612,11 @@ func (r ArtemisClusterResource) Read(
 		return
 	}
 	vmACLs := vmItems[0].Data.ACLs
-	vmConfig := vmItems[0].Data.Config.(entities.VMItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 	clusterName := strings.SplitN(clusterConfig.ClusterName, "-", 2)
 	hostsCount := clusterConfig.HostsInfo.Count
 
@@ -668,8 +694,12 @@ func (r ArtemisClusterResource) Read(
 			PacketLimit: types.Int64Value(clusterConfig.Plugins.Limits.PacketLimit),
 			UniqueID:    types.BoolValue(clusterConfig.Plugins.UniqueID.Status),
 		},
+		PlannedActions: utils.PriorPlannedActions(ctx, req.State),
 	}
 
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_window"), &state.MaintenanceWindow)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_override"), &state.MaintenanceOverride)...)
+
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
 	if !lifetime.IsNull() {
@@ -690,6 +720,17 @@ func (r ArtemisClusterResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		r.plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	artemis, err := orders.GetArtemisOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -896,6 +937,44 @@ func (r ArtemisClusterResource) updateExtraMount(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func (r ArtemisClusterResource) plannedActions(state, plan *ArtemisClusterResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if plan.ProtocolAMQP != state.ProtocolAMQP {
+		actions.AddDisruptive("vtb-artemis_switch_protocol", "Изменение протоколов с перезапуском кластера")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("vtb-artemis_vertical_scaling_cluster", "Изменение конфигурации ВМ кластера с перезагрузкой")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("vtb-artemis_expand_mount", "Расширение точки монтирования")
+	}
+
+	if plan.LayoutID != state.LayoutID {
+		actions.AddDisruptive("vtb-artemis_scale", "Горизонтальное масштабирование с перезапуском кластера")
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+
+	if !plan.UpdateMode.IsNull() && plan.UpdateMode.ValueString() == "latest" {
+		actions.AddDisruptive("vtb-artemis_update", "Обновление версии релиза с перезапуском кластера")
+	}
+
+	if plan.Plugins != state.Plugins {
+		actions.AddDisruptive("vtb-artemis_switch_plugin", "Изменение плагинов с перезапуском кластера")
+	}
+	return actions
+}
+
 func (r ArtemisClusterResource) updateProtocols(
 	artemis *orders.ArtemisOrder,
 	plan *ArtemisClusterResourceModel,
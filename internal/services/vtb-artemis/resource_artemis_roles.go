@@ -135,7 +135,7 @@ func (r ArtemisRolesResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("vtb_artemis_order_id"), req, resp)
+	utils.ImportOrderState(ctx, r.client, path.Root("vtb_artemis_order_id"), artemisItemKinds, req, resp)
 }
 
 func (r ArtemisRolesResource) Create(
@@ -205,7 +205,12 @@ func (r ArtemisRolesResource) Read(
 		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
 		return
 	}
-	ssRolesList := parentItem.Data.Config.(entities.VTBArtemisItemConfig).RoleList
+	config, err := entities.ItemConfig[entities.VTBArtemisItemConfig](parentItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	ssRolesList := config.RoleList
 
 	state := ArtemisRolesResourceModel{
 		OrderID:  orderID,
@@ -434,8 +439,13 @@ func (r ArtemisRolesResource) checkRoles(
 		diags.AddError(consts.MODIFY_PLAN_FAIL, err.Error())
 		return
 	}
-	ssRolesList := parentItem.Data.Config.(entities.VTBArtemisItemConfig).RoleList
-	ssTuzList := parentItem.Data.Config.(entities.VTBArtemisItemConfig).TuzList
+	config, err := entities.ItemConfig[entities.VTBArtemisItemConfig](parentItem)
+	if err != nil {
+		diags.AddError(consts.MODIFY_PLAN_FAIL, err.Error())
+		return
+	}
+	ssRolesList := config.RoleList
+	ssTuzList := config.TuzList
 
 	var planRoleNames, planUserNames, ssRoleNames, ssUserNames []string
 	for _, ssRoleItem := range ssRolesList {
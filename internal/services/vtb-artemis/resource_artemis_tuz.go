@@ -134,7 +134,62 @@ func (r ArtemisTuzResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("vtb_artemis_order_id"), req, resp)
+	order, userName, ok := utils.SplitCompositeImportID(req.ID)
+	if !ok {
+		utils.ImportOrderState(ctx, r.client, path.Root("vtb_artemis_order_id"), artemisItemKinds, req, resp)
+		return
+	}
+
+	orderID, diags := utils.ResolveImportOrderID(r.client, order, artemisItemKinds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artemis, err := orders.GetArtemisOrder(r.client.Creds, r.client.ProjectName, orderID)
+	if err != nil {
+		resp.Diagnostics.AddError("Import resource", err.Error())
+		return
+	}
+
+	parentItem, err := artemis.GetParentItem()
+	if err != nil {
+		resp.Diagnostics.AddError("Import resource", err.Error())
+		return
+	}
+
+	config, ok := parentItem.Data.Config.(entities.VTBArtemisItemConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Import resource",
+			fmt.Sprintf("Invalid type for VTB Artemis item config: %T", parentItem.Data.Config),
+		)
+		return
+	}
+
+	// импортируется только указанная ТУЗ, остальные ТУЗ заказа ресурсом не управляются
+	var users []VTBArtemisTuzModel
+	for _, tuz := range config.TuzList {
+		if tuz.UserName == userName {
+			users = append(users, VTBArtemisTuzModel{
+				UserName:      types.StringValue(tuz.UserName),
+				UserOwnerCert: types.StringValue(tuz.UserOwnerCert),
+			})
+			break
+		}
+	}
+	if len(users) == 0 {
+		resp.Diagnostics.AddError(
+			"Import resource",
+			fmt.Sprintf("Can't find TUZ '%s' in VTB Artemis order (order_id=%s)", userName, orderID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &VTBArtemisTuzListModel{
+		OrderID: types.StringValue(orderID),
+		Users:   users,
+	})...)
 }
 
 func (r ArtemisTuzResource) ModifyPlan(
@@ -223,8 +278,12 @@ func (r ArtemisTuzResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
-	var orderID types.String
-	req.State.GetAttribute(ctx, path.Root("vtb_artemis_order_id"), &orderID)
+	var prevState VTBArtemisTuzListModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &prevState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	orderID := prevState.OrderID
 
 	artemis, err := orders.GetArtemisOrder(
 		r.client.Creds,
@@ -242,14 +301,30 @@ func (r ArtemisTuzResource) Read(
 		return
 	}
 
-	tuzList := parentItem.Data.Config.(entities.VTBArtemisItemConfig).TuzList
+	config, ok := parentItem.Data.Config.(entities.VTBArtemisItemConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			consts.READ_RES_FAIL,
+			fmt.Sprintf("Invalid type for VTB Artemis item config: %T", parentItem.Data.Config),
+		)
+		return
+	}
 
 	state := &VTBArtemisTuzListModel{
 		OrderID: orderID,
 		Users:   []VTBArtemisTuzModel{},
 	}
 
-	for _, tuz := range tuzList {
+	// после импорта по order_id в state нет ТУЗ, тогда читаются все ТУЗ заказа
+	managed := make(map[string]struct{}, len(prevState.Users))
+	for _, user := range prevState.Users {
+		managed[user.UserName.ValueString()] = struct{}{}
+	}
+
+	for _, tuz := range config.TuzList {
+		if _, ok := managed[tuz.UserName]; len(managed) > 0 && !ok {
+			continue
+		}
 		user := VTBArtemisTuzModel{
 			UserName:      types.StringValue(tuz.UserName),
 			UserOwnerCert: types.StringValue(tuz.UserOwnerCert),
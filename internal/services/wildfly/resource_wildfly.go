@@ -113,6 +113,9 @@ type WildflyResourceModel struct {
 	FinancialProject      types.String                      `tfsdk:"financial_project"`
 	BuildVersion          types.String                      `tfsdk:"build_version"`
 	MmModeEndDate         types.String                      `tfsdk:"mm_mode_end_date"`
+	PlannedActions        types.List                        `tfsdk:"planned_actions"`
+	MaintenanceWindow     *common.MaintenanceWindowModel    `tfsdk:"maintenance_window"`
+	MaintenanceOverride   types.Bool                        `tfsdk:"maintenance_override"`
 }
 
 func (r WildflyResource) Schema(
@@ -304,6 +307,9 @@ func (r WildflyResource) Schema(
 				Description:         "Источник финансирования.",
 				MarkdownDescription: "Источник финансирования.",
 			},
+			"planned_actions":      common.PlannedActionsSchema,
+			"maintenance_window":   common.MaintenanceWindowSchema,
+			"maintenance_override": common.MaintenanceOverrideSchema,
 		},
 	}
 }
@@ -313,7 +319,11 @@ func (r WildflyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("order_id"), req, resp)
+	utils.ImportOrderState(
+		ctx, r.client, path.Root("order_id"),
+		[]entities.ItemKind{{Type: "app", Provider: "wildfly"}},
+		req, resp,
+	)
 }
 
 func (r WildflyResource) ModifyPlan(
@@ -333,6 +343,9 @@ func (r WildflyResource) ModifyPlan(
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
+	validateFinProjectDiags := utils.ValidateFinancialProject(ctx, r.client, req, plan.FinancialProject)
+	resp.Diagnostics.Append(validateFinProjectDiags...)
+
 	checkOrderIsDeleted := utils.CheckOrderIsDeleted(
 		r.client,
 		plan.OrderID,
@@ -363,6 +376,12 @@ func (r WildflyResource) ModifyPlan(
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	actions := utils.PlannedActions{}
+	if !req.State.Raw.IsNull() && !checkOrderIsDeleted.IsDeleted {
+		actions = plannedActions(&state, &plan)
+	}
+	utils.SetPlannedActions(ctx, r.client, req, resp, actions)
 }
 
 func (r WildflyResource) Create(
@@ -425,8 +444,11 @@ func (r WildflyResource) Create(
 		return
 	}
 
-	itemData := vmItems[0].Data
-	config := itemData.Config.(entities.VMItemConfig)
+	config, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		resp.Diagnostics.AddError(consts.CREATE_RES_FAIL, err.Error())
+		return
+	}
 
 	plan.OrderID = types.StringValue(order.ID)
 	plan.ItemID = types.StringValue(vmItems[0].ID)
@@ -461,6 +483,7 @@ func (r WildflyResource) Create(
 
 	}
 
+	plan.PlannedActions = utils.EmptyPlannedActions()
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -538,8 +561,16 @@ func (r WildflyResource) Read(
 	}
 
 	vmItem := wildflyVMs[0]
-	vmConfig := vmItem.Data.Config.(entities.VMItemConfig)
-	wildflyConfig := wildflyItem.Data.Config.(entities.WildflyItemConfig)
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
+	wildflyConfig, err := entities.ItemConfig[entities.WildflyItemConfig](wildflyItem)
+	if err != nil {
+		resp.Diagnostics.AddError(consts.READ_RES_FAIL, err.Error())
+		return
+	}
 
 	var certAltNames basetypes.ListValue
 	if len(wildflyConfig.Certificate.AltNames) == 0 {
@@ -604,6 +635,7 @@ func (r WildflyResource) Read(
 			ADIntegration: types.BoolValue(vmConfig.AdIntegration),
 		},
 		FinancialProject: types.StringValue(order.FinancialSource.Name),
+		PlannedActions:   utils.PriorPlannedActions(ctx, req.State),
 	}
 
 	// read info about wildfly access groups
@@ -622,6 +654,9 @@ func (r WildflyResource) Read(
 	state.CertAltNames = certAltNames
 	state.ClientCert = clientCert
 
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_window"), &state.MaintenanceWindow)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("maintenance_override"), &state.MaintenanceOverride)...)
+
 	var lifetime types.Int64
 	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifetime"), &lifetime)...)
 	if !lifetime.IsNull() {
@@ -642,6 +677,17 @@ func (r WildflyResource) Update(
 		return
 	}
 
+	// план мог быть применен позже, чем построен, поэтому окно проверяется повторно
+	resp.Diagnostics.Append(utils.CheckPlanMaintenanceWindow(
+		ctx,
+		r.client,
+		req.Plan,
+		plannedActions(&state, &plan),
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	order, err := orders.GetWildflyOrder(
 		r.client.Creds,
 		r.client.ProjectName,
@@ -883,6 +929,68 @@ func (r WildflyResource) updateCert(
 	}
 }
 
+// plannedActions действия портала в порядке их вызова в Update
+func plannedActions(state, plan *WildflyResourceModel) utils.PlannedActions {
+	var actions utils.PlannedActions
+
+	if plan.Label != state.Label {
+		actions.Add("change_label", "Изменение метки заказа")
+	}
+
+	if plan.JavaVersion != state.JavaVersion {
+		actions.AddDisruptive("wildfly_release_change_java", "Изменение версии Java с перезапуском WildFly")
+	}
+
+	if !reflect.DeepEqual(plan.WildflyAccess, state.WildflyAccess) {
+		actions.Add("wildfly_release_groups", "Изменение групп управления WildFly")
+	}
+
+	if utils.IsExtraMountChanged(state.ExtraMounts, plan.ExtraMounts) {
+		actions.Add("expand_mount_point_new", "Расширение точки монтирования")
+	}
+
+	if !reflect.DeepEqual(plan.ActiveDirectoryAccess, state.ActiveDirectoryAccess) {
+		actions.Add("vm_acls", "Изменение групп доступа ВМ")
+	}
+
+	if plan.Flavor != state.Flavor {
+		actions.AddDisruptive("wildfly_release_vertical_scaling", "Изменение конфигурации ВМ с перезагрузкой")
+	}
+
+	if !plan.CertAltNames.Equal(state.CertAltNames) {
+		actions.AddDisruptive("wildfly_release_update_certs", "Обновление сертификатов с перезапуском WildFly")
+	}
+
+	if !plan.ClientCert.Equal(state.ClientCert) {
+		if plan.ClientCert.ValueBool() {
+			actions.AddDisruptive("wildfly_release_add_client_cert", "Выпуск клиентского сертификата с перезапуском WildFly")
+		} else {
+			actions.AddDisruptive("wildfly_release_delete_client_cert", "Удаление клиентского сертификата с перезапуском WildFly")
+		}
+	}
+
+	if !plan.MmModeEndDate.Equal(state.MmModeEndDate) {
+		if plan.MmModeEndDate.IsNull() {
+			actions.Add("wildfly_release_action_set_mm_off", "Выключение режима обслуживания")
+		} else {
+			actions.Add("wildfly_release_action_set_mm_on", "Включение режима обслуживания")
+		}
+	}
+
+	if !plan.ServiceStatus.Equal(state.ServiceStatus) {
+		if plan.ServiceStatus.ValueString() == "off" {
+			actions.AddDisruptive("wildfly_release_stop_wf", "Остановка сервиса WildFly")
+		} else {
+			actions.Add("wildfly_release_start_wf", "Запуск сервиса WildFly")
+		}
+	}
+
+	if !plan.FinancialProject.Equal(state.FinancialProject) {
+		actions.Add("change_financial_project", "Изменение источника финансирования")
+	}
+	return actions
+}
+
 func (r WildflyResource) SwitchServiceState(
 	order *orders.Wildfly,
 	plan *WildflyResourceModel,
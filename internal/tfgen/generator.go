@@ -0,0 +1,238 @@
+package tfgen
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const providerTypeName = "vtb"
+
+// Resource сгенерированный ресурс для одного заказа
+type Resource struct {
+	OrderID string
+	Type    string
+	Name    string
+	Body    string
+}
+
+// Skipped заказ, для которого не удалось сгенерировать ресурс
+type Skipped struct {
+	OrderID string
+	Label   string
+	Reason  string
+}
+
+// Result результат генерации по всем заказам проекта
+type Result struct {
+	Resources   []Resource
+	Unsupported []Skipped
+	Failed      []Skipped
+}
+
+type Generator struct {
+	client *client.CloudClient
+	names  map[string]map[string]bool
+}
+
+func NewGenerator(cloudClient *client.CloudClient) *Generator {
+	return &Generator{
+		client: cloudClient,
+		names:  map[string]map[string]bool{},
+	}
+}
+
+// Generate конвертирует все заказы проекта в ресурсы провайдера.
+// Состояние ресурса строится теми же ImportState и Read, что и при terraform import
+func (g *Generator) Generate(ctx context.Context) (*Result, error) {
+
+	projectOrders, err := orders.ListOrders(g.client.Creds, g.client.ProjectName)
+	if err != nil {
+		return nil, fmt.Errorf("can't get orders of project '%s': %w", g.client.ProjectName, err)
+	}
+
+	result := &Result{}
+	for _, order := range projectOrders {
+		skipped := Skipped{OrderID: order.ID, Label: order.Label}
+
+		items, err := orders.GetOrderItems(g.client.Creds, g.client.ProjectName, order.ID)
+		if err != nil {
+			skipped.Reason = fmt.Sprintf("can't get order items: %v", err)
+			result.Failed = append(result.Failed, skipped)
+			continue
+		}
+
+		item, product := findProduct(items)
+		if product == nil {
+			skipped.Reason = "no supported parent item"
+			if item != nil {
+				skipped.Reason = fmt.Sprintf("unsupported item type='%s' provider='%s'", item.Type, item.Data.Provider)
+			}
+			result.Unsupported = append(result.Unsupported, skipped)
+			continue
+		}
+
+		generated, err := g.generateResource(ctx, product.newResource(), order)
+		if err != nil {
+			skipped.Reason = err.Error()
+			result.Failed = append(result.Failed, skipped)
+			continue
+		}
+		result.Resources = append(result.Resources, *generated)
+	}
+	return result, nil
+}
+
+func (g *Generator) generateResource(
+	ctx context.Context,
+	r resource.Resource,
+	order orders.Order,
+) (generated *Resource, err error) {
+
+	// ошибка в Read одного ресурса не должна прерывать генерацию по остальным заказам
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("panic while reading order: %v", recovered)
+		}
+	}()
+
+	metadataResp := &resource.MetadataResponse{}
+	r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, metadataResp)
+
+	importer, ok := r.(resource.ResourceWithImportState)
+	if !ok {
+		return nil, fmt.Errorf("resource %s doesn't support import", metadataResp.TypeName)
+	}
+
+	if configurable, ok := r.(resource.ResourceWithConfigure); ok {
+		configureResp := &resource.ConfigureResponse{}
+		configurable.Configure(ctx, resource.ConfigureRequest{ProviderData: g.client}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			return nil, diagnosticsError(configureResp.Diagnostics)
+		}
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(schemaResp.Diagnostics)
+	}
+	s := schemaResp.Schema
+
+	emptyState := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+
+	importResp := &resource.ImportStateResponse{State: emptyState}
+	importer.ImportState(ctx, resource.ImportStateRequest{ID: order.ID}, importResp)
+	if importResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(importResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(readResp.Diagnostics)
+	}
+	if readResp.State.Raw.IsNull() {
+		return nil, fmt.Errorf("order was removed from state while reading")
+	}
+
+	body, err := renderBody(s, readResp.State.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Resource{
+		OrderID: order.ID,
+		Type:    metadataResp.TypeName,
+		Name:    g.resourceName(metadataResp.TypeName, order),
+		Body:    body,
+	}, nil
+}
+
+var nameReplacer = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceName имя ресурса из метки заказа, уникальное в пределах типа ресурса
+func (g *Generator) resourceName(resourceType string, order orders.Order) string {
+	name := nameReplacer.ReplaceAllString(strings.ToLower(order.Label), "_")
+	name = strings.Trim(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "order_" + name
+		if name == "order_" {
+			name += strings.ReplaceAll(order.ID, "-", "_")
+		}
+	}
+
+	if g.names[resourceType] == nil {
+		g.names[resourceType] = map[string]bool{}
+	}
+	unique := name
+	for i := 2; g.names[resourceType][unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	g.names[resourceType][unique] = true
+	return unique
+}
+
+// WriteFiles записывает ресурсы в <dir>/<resource_type>.tf и блоки import в <dir>/imports.tf
+func WriteFiles(dir string, resources []Resource) error {
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	byType := map[string][]Resource{}
+	for _, r := range resources {
+		byType[r.Type] = append(byType[r.Type], r)
+	}
+
+	types := make([]string, 0, len(byType))
+	for resourceType := range byType {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+
+	var imports strings.Builder
+	for _, resourceType := range types {
+		var content strings.Builder
+		for i, r := range byType[resourceType] {
+			if i > 0 {
+				content.WriteString("\n")
+			}
+			fmt.Fprintf(&content, "resource %q %q {\n%s}\n", r.Type, r.Name, r.Body)
+
+			if imports.Len() > 0 {
+				imports.WriteString("\n")
+			}
+			fmt.Fprintf(&imports, "import {\n  to = %s.%s\n  id = %q\n}\n", r.Type, r.Name, r.OrderID)
+		}
+
+		err := os.WriteFile(filepath.Join(dir, resourceType+".tf"), []byte(content.String()), 0o644)
+		if err != nil {
+			return err
+		}
+	}
+	return os.WriteFile(filepath.Join(dir, "imports.tf"), []byte(imports.String()), 0o644)
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	messages := make([]string, 0)
+	for _, d := range diags.Errors() {
+		messages = append(messages, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
@@ -0,0 +1,128 @@
+package tfgen
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/fake"
+	"terraform-provider-vtb/pkg/client/orders"
+)
+
+// go test ./internal/tfgen -run TestGenerateGolden -update
+var update = flag.Bool("update", false, "rewrite golden files of generated configuration")
+
+// Сгенерированные файлы сравниваются с testdata/generate/<файл>.golden,
+// id заказов заменяются на <id метки заказа>, так как fake портал выдает случайные id
+const generateGolden = "testdata/generate"
+
+const (
+	computeProductID     = "fake-compute-product-id"
+	unsupportedProductID = "fake-unsupported-product-id"
+)
+
+func TestGenerateGolden(t *testing.T) {
+	s := fake.Start(t)
+	s.AddProduct(computeProductID, fake.ComputeProduct("astra"))
+	s.AddProduct(unsupportedProductID, fake.Product{ItemType: "unsupported", Provider: "unsupported"})
+
+	creds, err := auth.NewCredentials(fake.ClientID, fake.ClientSecret)
+	if err != nil {
+		t.Fatalf("Can't get access token from fake portal: %v", err)
+	}
+
+	labels := map[string]string{}
+	for _, label := range []string{"app-vm", "App VM", "${env} vm", "1st vm"} {
+		compute := orders.NewCompute(creds, fake.ProjectName, computeProductID, orders.ComputeAttrs{
+			BasicAttrs: orders.BasicAttrs{
+				Domain:      "corp.fake.ru",
+				Platform:    "vsphere",
+				OsVersion:   "1.7",
+				DefaultNic:  entities.DefaultNic{NetSegment: "dev-srv-app"},
+				Flavor:      entities.Flavor{Cores: 2, Memory: 4, Name: "c2m4", UUID: "flavor-c2m4"},
+				ExtraMounts: []entities.ExtraMount{{Path: "/app", Size: 10, FileSystem: "xfs"}},
+			},
+		})
+		err := compute.Create(orders.CreateOrderPayload{Label: label, FinProjectID: "fake-fin-project-id"})
+		if err != nil {
+			t.Fatalf("Create compute order '%s': %v", label, err)
+		}
+		labels[compute.ID] = label
+	}
+
+	unsupported := orders.NewCompute(creds, fake.ProjectName, unsupportedProductID, orders.ComputeAttrs{})
+	err = unsupported.Create(orders.CreateOrderPayload{Label: "unsupported", FinProjectID: "fake-fin-project-id"})
+	if err != nil {
+		t.Fatalf("Create unsupported order: %v", err)
+	}
+
+	project := s.Project
+	result, err := NewGenerator(client.NewCloudClient(creds, &project)).Generate(context.Background())
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if len(result.Failed) != 0 {
+		t.Fatalf("Unexpected failed orders: %+v", result.Failed)
+	}
+	if len(result.Unsupported) != 1 || result.Unsupported[0].OrderID != unsupported.ID {
+		t.Fatalf("Expected only order '%s' to be unsupported, got %+v", unsupported.ID, result.Unsupported)
+	}
+
+	dir := t.TempDir()
+	if err := WriteFiles(dir, result.Resources); err != nil {
+		t.Fatalf("Write files: %v", err)
+	}
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(paths)
+
+	var generated []string
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for id, label := range labels {
+			content = []byte(strings.ReplaceAll(string(content), id, "<"+label+">"))
+		}
+
+		name := filepath.Base(path)
+		generated = append(generated, name)
+		goldenPath := filepath.Join(generateGolden, name+".golden")
+		if *update {
+			if err := os.MkdirAll(generateGolden, 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(goldenPath, content, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		golden, err := os.ReadFile(goldenPath)
+		if err != nil {
+			t.Fatalf("Can't read golden file, run test with -update: %v", err)
+		}
+		if string(golden) != string(content) {
+			t.Fatalf("Generated %s doesn't match %s:\n%s", name, goldenPath, content)
+		}
+	}
+
+	goldenPaths, err := filepath.Glob(filepath.Join(generateGolden, "*.golden"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !*update && len(goldenPaths) != len(generated) {
+		t.Fatalf("Expected files for %v, generated %v", goldenPaths, generated)
+	}
+}
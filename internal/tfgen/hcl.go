@@ -0,0 +1,290 @@
+package tfgen
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const indentUnit = "  "
+
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// renderBody HCL тело ресурса. В конфигурацию попадают только аргументы:
+// computed атрибуты и null значения пропускаются
+func renderBody(s schema.Schema, raw tftypes.Value) (string, error) {
+	var b strings.Builder
+	err := renderObject(&b, 1, s.Attributes, s.Blocks, raw)
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func renderObject(
+	b *strings.Builder,
+	indent int,
+	attributes map[string]schema.Attribute,
+	blocks map[string]schema.Block,
+	value tftypes.Value,
+) error {
+
+	var fields map[string]tftypes.Value
+	if err := value.As(&fields); err != nil {
+		return err
+	}
+	prefix := strings.Repeat(indentUnit, indent)
+
+	for _, name := range sortedKeys(attributes) {
+		attribute := attributes[name]
+		if attribute.IsComputed() && !attribute.IsOptional() && !attribute.IsRequired() {
+			continue
+		}
+
+		field, ok := fields[name]
+		if !ok || !field.IsKnown() {
+			continue
+		}
+		if field.IsNull() {
+			if attribute.IsRequired() {
+				fmt.Fprintf(b, "%s# TODO: %s is required, but portal didn't return its value\n", prefix, name)
+			}
+			continue
+		}
+		if attribute.IsSensitive() {
+			fmt.Fprintf(b, "%s# TODO: %s is sensitive, set it manually\n", prefix, name)
+			continue
+		}
+
+		rendered, err := renderAttribute(attribute, field, indent)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		fmt.Fprintf(b, "%s%s = %s\n", prefix, name, rendered)
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		field, ok := fields[name]
+		if !ok || !field.IsKnown() || field.IsNull() {
+			continue
+		}
+
+		var (
+			object   schema.NestedBlockObject
+			elements []tftypes.Value
+		)
+		switch block := blocks[name].(type) {
+		case schema.ListNestedBlock:
+			object = block.NestedObject
+		case schema.SetNestedBlock:
+			object = block.NestedObject
+		case schema.SingleNestedBlock:
+			object = schema.NestedBlockObject{Attributes: block.Attributes, Blocks: block.Blocks}
+			elements = []tftypes.Value{field}
+		default:
+			return fmt.Errorf("%s: unsupported block type %T", name, block)
+		}
+		if elements == nil {
+			if err := field.As(&elements); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+		}
+
+		for _, element := range elements {
+			fmt.Fprintf(b, "\n%s%s {\n", prefix, name)
+			err := renderObject(b, indent+1, object.Attributes, object.Blocks, element)
+			if err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
+			fmt.Fprintf(b, "%s}\n", prefix)
+		}
+	}
+	return nil
+}
+
+func renderAttribute(attribute schema.Attribute, value tftypes.Value, indent int) (string, error) {
+
+	switch a := attribute.(type) {
+	case schema.SingleNestedAttribute:
+		return renderNestedObject(a.Attributes, value, indent)
+
+	case schema.ListNestedAttribute, schema.SetNestedAttribute:
+		var attributes map[string]schema.Attribute
+		if list, ok := a.(schema.ListNestedAttribute); ok {
+			attributes = list.NestedObject.Attributes
+		} else {
+			attributes = a.(schema.SetNestedAttribute).NestedObject.Attributes
+		}
+
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		if len(elements) == 0 {
+			return "[]", nil
+		}
+
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, element := range elements {
+			rendered, err := renderNestedObject(attributes, element, indent+1)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&b, "%s%s,\n", strings.Repeat(indentUnit, indent+1), rendered)
+		}
+		fmt.Fprintf(&b, "%s]", strings.Repeat(indentUnit, indent))
+		return b.String(), nil
+
+	case schema.MapNestedAttribute:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		if len(elements) == 0 {
+			return "{}", nil
+		}
+
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, key := range sortedKeys(elements) {
+			rendered, err := renderNestedObject(a.NestedObject.Attributes, elements[key], indent+1)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&b, "%s%s = %s\n", strings.Repeat(indentUnit, indent+1), renderKey(key), rendered)
+		}
+		fmt.Fprintf(&b, "%s}", strings.Repeat(indentUnit, indent))
+		return b.String(), nil
+	}
+	return renderValue(value, indent)
+}
+
+func renderNestedObject(attributes map[string]schema.Attribute, value tftypes.Value, indent int) (string, error) {
+	var b strings.Builder
+	b.WriteString("{\n")
+	if err := renderObject(&b, indent+1, attributes, nil, value); err != nil {
+		return "", err
+	}
+	fmt.Fprintf(&b, "%s}", strings.Repeat(indentUnit, indent))
+	return b.String(), nil
+}
+
+// renderValue HCL выражение для значения атрибута без вложенной схемы
+func renderValue(value tftypes.Value, indent int) (string, error) {
+
+	if value.IsNull() {
+		return "null", nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		if err := value.As(&s); err != nil {
+			return "", err
+		}
+		return quote(s), nil
+
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		if err := value.As(&n); err != nil {
+			return "", err
+		}
+		return n.Text('f', -1), nil
+
+	case value.Type().Is(tftypes.Bool):
+		var v bool
+		if err := value.As(&v); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%t", v), nil
+	}
+
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		rendered := make([]string, 0, len(elements))
+		multiline := false
+		for _, element := range elements {
+			r, err := renderValue(element, indent+1)
+			if err != nil {
+				return "", err
+			}
+			multiline = multiline || strings.Contains(r, "\n")
+			rendered = append(rendered, r)
+		}
+		if !multiline {
+			return "[" + strings.Join(rendered, ", ") + "]", nil
+		}
+
+		var b strings.Builder
+		b.WriteString("[\n")
+		for _, r := range rendered {
+			fmt.Fprintf(&b, "%s%s,\n", strings.Repeat(indentUnit, indent+1), r)
+		}
+		fmt.Fprintf(&b, "%s]", strings.Repeat(indentUnit, indent))
+		return b.String(), nil
+
+	case tftypes.Map, tftypes.Object:
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return "", err
+		}
+		if len(elements) == 0 {
+			return "{}", nil
+		}
+
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, key := range sortedKeys(elements) {
+			r, err := renderValue(elements[key], indent+1)
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(&b, "%s%s = %s\n", strings.Repeat(indentUnit, indent+1), renderKey(key), r)
+		}
+		fmt.Fprintf(&b, "%s}", strings.Repeat(indentUnit, indent))
+		return b.String(), nil
+	}
+	return "", fmt.Errorf("unsupported value type %s", value.Type())
+}
+
+func renderKey(key string) string {
+	if identifierRegexp.MatchString(key) {
+		return key
+	}
+	return quote(key)
+}
+
+var quoteReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+// quote строка HCL. Последовательности шаблонов ${ и %{ экранируются,
+// чтобы значения с портала не интерпретировались как интерполяция
+func quote(s string) string {
+	return `"` + quoteReplacer.Replace(s) + `"`
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
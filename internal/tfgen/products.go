@@ -0,0 +1,129 @@
+package tfgen
+
+import (
+	"strings"
+
+	agentorchestration "terraform-provider-vtb/internal/services/agent_orchestration"
+	"terraform-provider-vtb/internal/services/airflow"
+	"terraform-provider-vtb/internal/services/astra"
+	balancerv3 "terraform-provider-vtb/internal/services/balancer_v3"
+	"terraform-provider-vtb/internal/services/clickhouse"
+	"terraform-provider-vtb/internal/services/elasticsearch"
+	"terraform-provider-vtb/internal/services/etcd"
+	"terraform-provider-vtb/internal/services/grafana"
+	gslbv1 "terraform-provider-vtb/internal/services/gslb_v1"
+	k8scluster "terraform-provider-vtb/internal/services/k8s_cluster"
+	k8scontainerspace "terraform-provider-vtb/internal/services/k8s_container_space"
+	k8sproject "terraform-provider-vtb/internal/services/k8s_project"
+	"terraform-provider-vtb/internal/services/kafka"
+	"terraform-provider-vtb/internal/services/ktaas"
+	"terraform-provider-vtb/internal/services/nginx"
+	openmessaging "terraform-provider-vtb/internal/services/open_messaging"
+	"terraform-provider-vtb/internal/services/postgresql"
+	"terraform-provider-vtb/internal/services/rabbitmq"
+	"terraform-provider-vtb/internal/services/redis"
+	redissentinel "terraform-provider-vtb/internal/services/redis_sentinel"
+	"terraform-provider-vtb/internal/services/rqaas"
+	s3ceph "terraform-provider-vtb/internal/services/s3_ceph"
+	scylladb "terraform-provider-vtb/internal/services/scylla-db"
+	syncxpert "terraform-provider-vtb/internal/services/sync-xpert"
+	"terraform-provider-vtb/internal/services/tarantool"
+	vtbartemis "terraform-provider-vtb/internal/services/vtb-artemis"
+	"terraform-provider-vtb/internal/services/wildfly"
+	"terraform-provider-vtb/pkg/client/entities"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// product связывает родительский item заказа с ресурсом провайдера
+type product struct {
+	itemTypes   []string
+	providers   []string
+	newResource func() resource.Resource
+}
+
+// products порядок совпадает с разбором items в entities.Item.UnmarshalJSON
+var products = []product{
+	{[]string{"vm"}, nil, astra.NewComputeResource},
+	{[]string{"cluster"}, []string{"kafka"}, kafka.NewKafkaResource},
+	{[]string{"cluster"}, []string{"*kubernetes_v1*"}, k8scluster.NewK8sClusterResource},
+	{[]string{"project"}, []string{"kubernetes"}, k8sproject.NewK8sProjectResource},
+	{[]string{"container_space"}, []string{"kubernetes"}, k8scontainerspace.NewK8sContainerSpaceResource},
+	{[]string{"app"}, []string{"wildfly"}, wildfly.NewWildflyResource},
+	{[]string{"app", "cluster"}, []string{"postgresql_v001"}, postgresql.NewPostgresqlResource},
+	{[]string{"app", "cluster"}, []string{"redis"}, redis.NewRedisResource},
+	{[]string{"app"}, []string{"redis_sentinel"}, redissentinel.NewRedisSentinelResource},
+	{[]string{"app"}, []string{"clickhouse"}, clickhouse.NewClickHouseResource},
+	{[]string{"cluster"}, []string{"clickhouse"}, clickhouse.NewClickHouseClusterResource},
+	{[]string{"app"}, []string{"nginx", "nginx_develop"}, nginx.NewNginxResource},
+	{[]string{"app"}, []string{"agent_orchestration"}, agentorchestration.NewAgentOrchestrationResource},
+	{[]string{"cluster"}, []string{"rabbitmq", "rabbitmq_develop"}, rabbitmq.NewRabbitMQResource},
+	{[]string{"cluster"}, []string{"vtb-artemis"}, vtbartemis.NewArtemisResource},
+	{[]string{"app"}, []string{"artemis", "artemis_lt"}, openmessaging.NewOpenMessagingResource},
+	{[]string{"cluster"}, []string{"debezium"}, syncxpert.NewSyncXpertClusterResource},
+	{[]string{"cluster"}, []string{"*balancer_v3*"}, balancerv3.NewBalancerV3Resource},
+	{[]string{"cluster"}, []string{"airflow"}, airflow.NewAirflowClusterResource},
+	{[]string{"app"}, []string{"airflow"}, airflow.NewAirflowStandaloneResource},
+	{[]string{"cluster"}, []string{"etcd"}, etcd.NewEtcdResource},
+	{[]string{"app"}, []string{"grafana"}, grafana.NewGrafanaResource},
+	{[]string{"cluster"}, []string{"tarantool_v2"}, tarantool.NewTarantoolClusterResource},
+	{[]string{"saas"}, []string{"rqaas"}, rqaas.NewRQaaSResource},
+	{[]string{"paas_ktaas"}, []string{"ktaas"}, ktaas.NewKTaaSResource},
+	{[]string{"cluster"}, []string{"elasticsearch_os"}, elasticsearch.NewElasticSearchResource},
+	{[]string{"cluster"}, []string{"scylladb"}, scylladb.NewScyllaDbClusterResource},
+	{[]string{"s3"}, []string{"ceph"}, s3ceph.NewS3CephResource},
+	{[]string{"cluster"}, []string{"*gslb_cluster_v1*"}, gslbv1.NewGSLBV1Resource},
+}
+
+func (p product) matches(item entities.Item) bool {
+	if !contains(p.itemTypes, item.Type) {
+		return false
+	}
+	if p.providers == nil {
+		return true
+	}
+	for _, provider := range p.providers {
+		if matchPattern(provider, item.Data.Provider) {
+			return true
+		}
+	}
+	return false
+}
+
+// findProduct ищет ресурс провайдера по родительскому item заказа
+func findProduct(items []entities.Item) (*entities.Item, *product) {
+	for _, item := range items {
+		if item.Data.Parent != "" {
+			continue
+		}
+		for i := range products {
+			if products[i].matches(item) {
+				return &item, &products[i]
+			}
+		}
+		return &item, nil
+	}
+	return nil, nil
+}
+
+// matchPattern поддерживает '*' в начале и в конце шаблона
+func matchPattern(pattern, value string) bool {
+	switch {
+	case strings.HasPrefix(pattern, "*") && strings.HasSuffix(pattern, "*"):
+		return strings.Contains(value, strings.Trim(pattern, "*"))
+	case strings.HasSuffix(pattern, "*"):
+		return strings.HasPrefix(value, strings.TrimSuffix(pattern, "*"))
+	case strings.HasPrefix(pattern, "*"):
+		return strings.HasSuffix(value, strings.TrimPrefix(pattern, "*"))
+	}
+	return pattern == value
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
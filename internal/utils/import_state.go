@@ -0,0 +1,188 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/orders"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+const (
+	importPrefixLabel       = "label:"
+	importPrefixClusterName = "cluster_name:"
+	importPrefixHostname    = "hostname:"
+)
+
+// ImportOrderState импорт ресурса по order_id или по идентификаторам вида
+// label:<label>, cluster_name:<name>, hostname:<fqdn>.
+// kinds основной item заказов продукта ресурса: заказы других продуктов при поиске пропускаются
+func ImportOrderState(
+	ctx context.Context,
+	cloudClient *client.CloudClient,
+	attrPath path.Path,
+	kinds []entities.ItemKind,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	orderID, diags := ResolveImportOrderID(cloudClient, req.ID, kinds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, orderID)...)
+}
+
+// ResolveImportOrderID возвращает order_id заказа по идентификатору импорта.
+// Идентификатор без префикса считается order_id. При поиске учитываются только заказы,
+// основной item которых подходит под один из kinds, пустой kinds не ограничивает поиск
+func ResolveImportOrderID(
+	cloudClient *client.CloudClient,
+	importID string,
+	kinds []entities.ItemKind,
+) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var (
+		found []orders.Order
+		err   error
+	)
+
+	switch {
+	case strings.HasPrefix(importID, importPrefixLabel),
+		strings.HasPrefix(importID, importPrefixClusterName),
+		strings.HasPrefix(importID, importPrefixHostname):
+		if cloudClient == nil {
+			diags.AddError(
+				"Import resource",
+				"Provider is not configured, can't search order by "+importID,
+			)
+			return "", diags
+		}
+	default:
+		if importID == "" {
+			diags.AddError("Import resource", "Import identifier can't be empty")
+		}
+		return importID, diags
+	}
+
+	switch {
+	case strings.HasPrefix(importID, importPrefixLabel):
+		found, err = orders.FindOrdersByLabel(
+			cloudClient.Creds,
+			cloudClient.ProjectName,
+			strings.TrimPrefix(importID, importPrefixLabel),
+			kinds,
+		)
+	case strings.HasPrefix(importID, importPrefixClusterName):
+		found, err = orders.FindOrdersByClusterName(
+			cloudClient.Creds,
+			cloudClient.ProjectName,
+			strings.TrimPrefix(importID, importPrefixClusterName),
+			kinds,
+		)
+	case strings.HasPrefix(importID, importPrefixHostname):
+		found, err = orders.FindOrdersByHostname(
+			cloudClient.Creds,
+			cloudClient.ProjectName,
+			strings.TrimPrefix(importID, importPrefixHostname),
+			kinds,
+		)
+	}
+	if err != nil {
+		diags.AddError(
+			"Import resource",
+			fmt.Sprintf("Search order by '%s' ended with error.\nError: %s", importID, err.Error()),
+		)
+		return "", diags
+	}
+
+	if len(found) == 0 {
+		diags.AddError(
+			"Import resource",
+			fmt.Sprintf(
+				"Can't find order%s by '%s' in project '%s'",
+				describeKinds(kinds), importID, cloudClient.ProjectName,
+			),
+		)
+		return "", diags
+	}
+
+	if len(found) > 1 {
+		candidates := make([]string, 0, len(found))
+		for _, order := range found {
+			candidates = append(candidates, fmt.Sprintf("%s (label='%s')", order.ID, order.Label))
+		}
+		diags.AddError(
+			"Import resource",
+			fmt.Sprintf(
+				"Found %d orders%s by '%s', import by order_id instead. Candidates: %s",
+				len(found), describeKinds(kinds), importID, strings.Join(candidates, ", "),
+			),
+		)
+		return "", diags
+	}
+	return found[0].ID, diags
+}
+
+// describeKinds описание kinds для текста ошибки, например " with main item cluster (kafka)"
+func describeKinds(kinds []entities.ItemKind) string {
+	if len(kinds) == 0 {
+		return ""
+	}
+	described := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		if kind.Provider == "" {
+			described = append(described, kind.Type)
+			continue
+		}
+		described = append(described, fmt.Sprintf("%s (%s)", kind.Type, strings.Trim(kind.Provider, "*")))
+	}
+	return " with main item " + strings.Join(described, " or ")
+}
+
+// SplitCompositeImportID разбивает идентификатор вида <order>/<name>.
+// Часть <order> может быть order_id или идентификатором с префиксом, например label:<label>
+func SplitCompositeImportID(importID string) (order, name string, ok bool) {
+	index := strings.LastIndex(importID, "/")
+	if index <= 0 || index == len(importID)-1 {
+		return "", "", false
+	}
+	return importID[:index], importID[index+1:], true
+}
+
+// ImportCompositeState импорт ресурса по идентификатору вида <order>/<name>:
+// order_id записывается в orderPath, <name> возвращается для записи в state.
+// kinds основной item заказов продукта, expected описывает формат идентификатора в тексте ошибки
+func ImportCompositeState(
+	ctx context.Context,
+	cloudClient *client.CloudClient,
+	orderPath path.Path,
+	kinds []entities.ItemKind,
+	expected string,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) (string, bool) {
+	order, name, ok := SplitCompositeImportID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Import resource",
+			fmt.Sprintf("Unexpected import id '%s'. Expected %s", req.ID, expected),
+		)
+		return "", false
+	}
+
+	orderID, diags := ResolveImportOrderID(cloudClient, order, kinds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return "", false
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, orderPath, orderID)...)
+	return name, !resp.Diagnostics.HasError()
+}
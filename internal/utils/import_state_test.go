@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSplitCompositeImportID(t *testing.T) {
+	cases := []struct {
+		importID  string
+		wantOrder string
+		wantName  string
+		wantOK    bool
+	}{
+		{importID: "order-id/topic", wantOrder: "order-id", wantName: "topic", wantOK: true},
+		{importID: "label:kafka/prod/topic", wantOrder: "label:kafka/prod", wantName: "topic", wantOK: true},
+		{importID: "order-id", wantOK: false},
+		{importID: "/topic", wantOK: false},
+		{importID: "order-id/", wantOK: false},
+	}
+
+	for _, c := range cases {
+		order, name, ok := SplitCompositeImportID(c.importID)
+		if order != c.wantOrder || name != c.wantName || ok != c.wantOK {
+			t.Fatalf(
+				"SplitCompositeImportID(%q) = %q, %q, %v; expected %q, %q, %v",
+				c.importID, order, name, ok, c.wantOrder, c.wantName, c.wantOK,
+			)
+		}
+	}
+}
+
+func TestImportCompositeState(t *testing.T) {
+	ctx := context.Background()
+	importSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"order_id": schema.StringAttribute{Required: true},
+		},
+	}
+	newResponse := func() *resource.ImportStateResponse {
+		return &resource.ImportStateResponse{
+			State: tfsdk.State{
+				Schema: importSchema,
+				Raw:    tftypes.NewValue(importSchema.Type().TerraformType(ctx), nil),
+			},
+		}
+	}
+
+	resp := newResponse()
+	name, ok := ImportCompositeState(
+		ctx, nil, path.Root("order_id"), nil, "<order_id>/<name>",
+		resource.ImportStateRequest{ID: "order-id/name"}, resp,
+	)
+	if !ok || name != "name" || resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected import result %q, %v: %v", name, ok, resp.Diagnostics)
+	}
+	var orderID types.String
+	resp.State.GetAttribute(ctx, path.Root("order_id"), &orderID)
+	if orderID.ValueString() != "order-id" {
+		t.Fatalf("Expected order_id 'order-id', got %s", orderID)
+	}
+
+	resp = newResponse()
+	if _, ok := ImportCompositeState(
+		ctx, nil, path.Root("order_id"), nil, "<order_id>/<name>",
+		resource.ImportStateRequest{ID: "order-id"}, resp,
+	); ok || !resp.Diagnostics.HasError() {
+		t.Fatalf("Expected error for import id without name")
+	}
+
+	resp = newResponse()
+	if _, ok := ImportCompositeState(
+		ctx, nil, path.Root("order_id"), nil, "<order_id>/<name>",
+		resource.ImportStateRequest{ID: "label:kafka/name"}, resp,
+	); ok || !resp.Diagnostics.HasError() {
+		t.Fatalf("Expected error for label search without configured provider")
+	}
+}
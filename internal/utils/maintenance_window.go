@@ -0,0 +1,139 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
+	"terraform-provider-vtb/internal/consts"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NewMaintenanceWindow преобразует окно обслуживания из terraform модели
+func NewMaintenanceWindow(
+	ctx context.Context,
+	model *common.MaintenanceWindowModel,
+) (*client.MaintenanceWindow, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if model == nil {
+		return nil, diags
+	}
+
+	var days []string
+	diags.Append(model.Days.ElementsAs(ctx, &days, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	window, err := client.ParseMaintenanceWindow(
+		days,
+		model.Start.ValueString(),
+		model.Duration.ValueString(),
+		model.Timezone.ValueString(),
+	)
+	if err != nil {
+		diags.AddAttributeError(path.Root("maintenance_window"), consts.VALIDATION_FAIL, err.Error())
+		return nil, diags
+	}
+	return window, diags
+}
+
+// CheckMaintenanceWindow запрещает disruptive действия вне окна обслуживания.
+// Окно ресурса приоритетнее окна провайдера, при отсутствии обоих проверка не выполняется
+func CheckMaintenanceWindow(
+	ctx context.Context,
+	cloudClient *client.CloudClient,
+	model *common.MaintenanceWindowModel,
+	override types.Bool,
+	actions PlannedActions,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model != nil && !isMaintenanceWindowKnown(model) {
+		return diags
+	}
+
+	window, diags := NewMaintenanceWindow(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+	if window == nil && cloudClient != nil {
+		window = cloudClient.MaintenanceWindow
+	}
+
+	if window == nil || !actions.HasDisruptive() {
+		return diags
+	}
+
+	if override.ValueBool() || (cloudClient != nil && cloudClient.ForceOutsideWindow) {
+		return diags
+	}
+
+	if window.Contains(time.Now()) {
+		return diags
+	}
+
+	names := make([]string, 0)
+	for _, action := range actions.Disruptive() {
+		names = append(names, action.Name)
+	}
+	diags.AddError(
+		"Disruptive actions outside maintenance window",
+		fmt.Sprintf(
+			"Actions [%s] restart the service or reboot its virtual machines and are allowed only "+
+				"within maintenance window '%s'. Apply the changes within the window or set "+
+				"maintenance_override = true in the resource, if it supports the attribute, "+
+				"or force_outside_window = true in provider configuration",
+			strings.Join(names, ", "), window,
+		),
+	)
+	return diags
+}
+
+// CheckPlanMaintenanceWindow CheckMaintenanceWindow для плана ресурса. Окно и maintenance_override
+// берутся из плана, если они есть в схеме ресурса, иначе действует только окно провайдера
+func CheckPlanMaintenanceWindow(
+	ctx context.Context,
+	cloudClient *client.CloudClient,
+	plan tfsdk.Plan,
+	actions PlannedActions,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var model *common.MaintenanceWindowModel
+	var override types.Bool
+
+	attributes := plan.Schema.GetAttributes()
+	if _, ok := attributes["maintenance_window"]; ok {
+		var window types.Object
+		diags.Append(plan.GetAttribute(ctx, path.Root("maintenance_window"), &window)...)
+		if diags.HasError() || window.IsUnknown() {
+			return diags
+		}
+		if !window.IsNull() {
+			model = &common.MaintenanceWindowModel{}
+			diags.Append(window.As(ctx, model, basetypes.ObjectAsOptions{})...)
+		}
+	}
+	if _, ok := attributes["maintenance_override"]; ok {
+		diags.Append(plan.GetAttribute(ctx, path.Root("maintenance_override"), &override)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	return CheckMaintenanceWindow(ctx, cloudClient, model, override, actions)
+}
+
+func isMaintenanceWindowKnown(model *common.MaintenanceWindowModel) bool {
+	return !model.Days.IsUnknown() &&
+		!model.Start.IsUnknown() &&
+		!model.Duration.IsUnknown() &&
+		!model.Timezone.IsUnknown()
+}
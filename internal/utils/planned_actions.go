@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PlannedAction действие портала, которое будет вызвано в Update
+type PlannedAction struct {
+	Name        string
+	Description string
+	Disruptive  bool
+}
+
+type PlannedActions []PlannedAction
+
+func (a *PlannedActions) Add(name, description string) {
+	*a = append(*a, PlannedAction{Name: name, Description: description})
+}
+
+// AddDisruptive добавляет действие, которое перезагружает ВМ или перезапускает кластер
+func (a *PlannedActions) AddDisruptive(name, description string) {
+	*a = append(*a, PlannedAction{Name: name, Description: description, Disruptive: true})
+}
+
+func (a PlannedActions) HasDisruptive() bool {
+	for _, action := range a {
+		if action.Disruptive {
+			return true
+		}
+	}
+	return false
+}
+
+func (a PlannedActions) Disruptive() PlannedActions {
+	var disruptive PlannedActions
+	for _, action := range a {
+		if action.Disruptive {
+			disruptive = append(disruptive, action)
+		}
+	}
+	return disruptive
+}
+
+func (a PlannedActions) ToTerraform(ctx context.Context) (types.List, diag.Diagnostics) {
+	models := make([]common.PlannedActionModel, 0, len(a))
+	for _, action := range a {
+		models = append(models, common.PlannedActionModel{
+			Name:        types.StringValue(action.Name),
+			Description: types.StringValue(action.Description),
+			Disruptive:  types.BoolValue(action.Disruptive),
+		})
+	}
+	return types.ListValueFrom(
+		ctx,
+		types.ObjectType{AttrTypes: common.PlannedActionModel{}.AttributeTypes()},
+		models,
+	)
+}
+
+// EmptyPlannedActions значение planned_actions для state после Create и импорта
+func EmptyPlannedActions() types.List {
+	return types.ListValueMust(
+		types.ObjectType{AttrTypes: common.PlannedActionModel{}.AttributeTypes()},
+		nil,
+	)
+}
+
+// PriorPlannedActions planned_actions из state для Read. Действия последнего
+// применения сохраняются, чтобы обновление state не затирало их
+func PriorPlannedActions(ctx context.Context, state tfsdk.State) types.List {
+	var actions types.List
+	state.GetAttribute(ctx, path.Root("planned_actions"), &actions)
+	if actions.IsNull() || actions.IsUnknown() {
+		return EmptyPlannedActions()
+	}
+	return actions
+}
+
+// SetPlannedActions записывает planned_actions в план,
+// выводит предупреждение для каждого disruptive действия и запрещает их вне окна обслуживания.
+// План без изменений сохраняет значение из state, чтобы не появлялся пустой diff
+func SetPlannedActions(
+	ctx context.Context,
+	cloudClient *client.CloudClient,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+	actions PlannedActions,
+) {
+	if len(actions) == 0 && !req.State.Raw.IsNull() && resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	tfActions, diags := actions.ToTerraform(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("planned_actions"), tfActions)...)
+
+	for _, action := range actions.Disruptive() {
+		resp.Diagnostics.AddWarning(
+			"Disruptive action planned",
+			fmt.Sprintf(
+				"Action '%s' (%s) will restart the service or reboot its virtual machines",
+				action.Name, action.Description,
+			),
+		)
+	}
+
+	resp.Diagnostics.Append(CheckPlanMaintenanceWindow(ctx, cloudClient, resp.Plan, actions)...)
+}
@@ -0,0 +1,205 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type plannedActionsTestModel struct {
+	Label          types.String `tfsdk:"label"`
+	PlannedActions types.List   `tfsdk:"planned_actions"`
+}
+
+var plannedActionsTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"label":           schema.StringAttribute{Optional: true},
+		"planned_actions": common.PlannedActionsSchema,
+	},
+}
+
+func plannedActionsTestValue(t *testing.T, label string, actions PlannedActions) tftypes.Value {
+	ctx := context.Background()
+	tfActions, diags := actions.ToTerraform(ctx)
+	if diags.HasError() {
+		t.Fatalf("Convert planned actions: %v", diags)
+	}
+
+	state := tfsdk.State{
+		Schema: plannedActionsTestSchema,
+		Raw:    tftypes.NewValue(plannedActionsTestSchema.Type().TerraformType(ctx), nil),
+	}
+	diags = state.Set(ctx, &plannedActionsTestModel{
+		Label:          types.StringValue(label),
+		PlannedActions: tfActions,
+	})
+	if diags.HasError() {
+		t.Fatalf("Set test state: %v", diags)
+	}
+	return state.Raw
+}
+
+func plannedActionNames(t *testing.T, plan tfsdk.Plan) []string {
+	var model plannedActionsTestModel
+	if diags := plan.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("Get plan: %v", diags)
+	}
+	var actions []common.PlannedActionModel
+	if diags := model.PlannedActions.ElementsAs(context.Background(), &actions, false); diags.HasError() {
+		t.Fatalf("Get planned actions: %v", diags)
+	}
+	names := []string{}
+	for _, action := range actions {
+		names = append(names, action.Name.ValueString())
+	}
+	return names
+}
+
+func TestPlannedActionsDisruptive(t *testing.T) {
+	var actions PlannedActions
+	actions.Add("change_label", "label")
+	if actions.HasDisruptive() {
+		t.Fatalf("Expected no disruptive actions in %v", actions)
+	}
+
+	actions.AddDisruptive("resize_vm", "resize")
+	actions.Add("vm_acls", "acls")
+	disruptive := actions.Disruptive()
+	if !actions.HasDisruptive() || len(disruptive) != 1 || disruptive[0].Name != "resize_vm" {
+		t.Fatalf("Unexpected disruptive actions: %v", disruptive)
+	}
+}
+
+func TestSetPlannedActions(t *testing.T) {
+	prior := PlannedActions{{Name: "resize_vm", Description: "resize", Disruptive: true}}
+
+	// окно провайдера, в которое текущий момент не попадает
+	closedWindow := &client.MaintenanceWindow{
+		Days:     []time.Weekday{(time.Now().UTC().Weekday() + 3) % 7},
+		Duration: time.Minute,
+		Location: time.UTC,
+	}
+
+	cases := []struct {
+		name         string
+		client       client.CloudClient
+		state        tftypes.Value
+		plan         tftypes.Value
+		actions      PlannedActions
+		wantNames    []string
+		wantWarnings int
+		wantError    bool
+	}{
+		{
+			name:      "create stores empty list",
+			state:     tftypes.NewValue(plannedActionsTestSchema.Type().TerraformType(context.Background()), nil),
+			plan:      plannedActionsTestValue(t, "vm", nil),
+			wantNames: []string{},
+		},
+		{
+			name:      "no changes keep actions of last apply",
+			state:     plannedActionsTestValue(t, "vm", prior),
+			plan:      plannedActionsTestValue(t, "vm", prior),
+			wantNames: []string{"resize_vm"},
+		},
+		{
+			name:      "changes without actions reset list",
+			state:     plannedActionsTestValue(t, "vm", prior),
+			plan:      plannedActionsTestValue(t, "new-vm", prior),
+			wantNames: []string{},
+		},
+		{
+			name:  "disruptive actions are warned",
+			state: plannedActionsTestValue(t, "vm", nil),
+			plan:  plannedActionsTestValue(t, "new-vm", nil),
+			actions: PlannedActions{
+				{Name: "change_label", Description: "label"},
+				{Name: "resize_vm", Description: "resize", Disruptive: true},
+			},
+			wantNames:    []string{"change_label", "resize_vm"},
+			wantWarnings: 1,
+		},
+		{
+			name:         "disruptive actions outside provider window",
+			client:       client.CloudClient{MaintenanceWindow: closedWindow},
+			state:        plannedActionsTestValue(t, "vm", nil),
+			plan:         plannedActionsTestValue(t, "new-vm", nil),
+			actions:      PlannedActions{{Name: "resize_vm", Description: "resize", Disruptive: true}},
+			wantNames:    []string{"resize_vm"},
+			wantWarnings: 1,
+			wantError:    true,
+		},
+		{
+			name:         "force_outside_window allows disruptive actions",
+			client:       client.CloudClient{MaintenanceWindow: closedWindow, ForceOutsideWindow: true},
+			state:        plannedActionsTestValue(t, "vm", nil),
+			plan:         plannedActionsTestValue(t, "new-vm", nil),
+			actions:      PlannedActions{{Name: "resize_vm", Description: "resize", Disruptive: true}},
+			wantNames:    []string{"resize_vm"},
+			wantWarnings: 1,
+		},
+		{
+			name:      "actions without restart outside provider window",
+			client:    client.CloudClient{MaintenanceWindow: closedWindow},
+			state:     plannedActionsTestValue(t, "vm", nil),
+			plan:      plannedActionsTestValue(t, "new-vm", nil),
+			actions:   PlannedActions{{Name: "change_label", Description: "label"}},
+			wantNames: []string{"change_label"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: plannedActionsTestSchema, Raw: c.state},
+				Plan:  tfsdk.Plan{Schema: plannedActionsTestSchema, Raw: c.plan},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			SetPlannedActions(context.Background(), &c.client, req, resp, c.actions)
+			if resp.Diagnostics.HasError() != c.wantError {
+				t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			names := plannedActionNames(t, resp.Plan)
+			if len(names) != len(c.wantNames) {
+				t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+			}
+			for i := range names {
+				if names[i] != c.wantNames[i] {
+					t.Fatalf("Expected actions %v, got %v", c.wantNames, names)
+				}
+			}
+			if resp.Diagnostics.WarningsCount() != c.wantWarnings {
+				t.Fatalf("Expected %d warnings, got %v", c.wantWarnings, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPriorPlannedActions(t *testing.T) {
+	ctx := context.Background()
+	prior := PlannedActions{{Name: "resize_vm", Description: "resize", Disruptive: true}}
+
+	imported := tfsdk.State{
+		Schema: plannedActionsTestSchema,
+		Raw:    tftypes.NewValue(plannedActionsTestSchema.Type().TerraformType(ctx), nil),
+	}
+	if actions := PriorPlannedActions(ctx, imported); actions.IsNull() || len(actions.Elements()) != 0 {
+		t.Fatalf("Expected empty list for imported state, got %v", actions)
+	}
+
+	applied := tfsdk.State{Schema: plannedActionsTestSchema, Raw: plannedActionsTestValue(t, "vm", prior)}
+	if actions := PriorPlannedActions(ctx, applied); len(actions.Elements()) != 1 {
+		t.Fatalf("Expected actions of last apply, got %v", actions)
+	}
+}
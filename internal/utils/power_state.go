@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PowerSwitcher заказ, который можно остановить и запустить
+type PowerSwitcher interface {
+	Stop(async bool) error
+	Start(async bool) error
+}
+
+// ReadPowerState приводит состояние элемента заказа к значению power_state для Read.
+// При промежуточном или неизвестном состоянии портала сохраняется значение из state,
+// чтобы оно не давало ложный diff. Без значения в state (импорт) заказ считается включенным
+func ReadPowerState(ctx context.Context, itemState string, state tfsdk.State) types.String {
+	switch itemState {
+	case "on", "off":
+		return types.StringValue(itemState)
+	}
+
+	var prior types.String
+	state.GetAttribute(ctx, path.Root("power_state"), &prior)
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.StringValue("on")
+	}
+	return prior
+}
+
+// ChangePowerState останавливает или запускает заказ в соответствии с powerState
+func ChangePowerState(order PowerSwitcher, powerState, summary string) (diags diag.Diagnostics) {
+	var err error
+	if powerState == "off" {
+		err = order.Stop(false)
+	} else {
+		err = order.Start(false)
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("power_state"),
+			summary,
+			fmt.Sprintf("Change power state to '%s' ended with error.\nError: %s", powerState, err.Error()),
+		)
+	}
+	return diags
+}
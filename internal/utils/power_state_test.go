@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReadPowerState(t *testing.T) {
+	ctx := context.Background()
+	powerStateSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"power_state": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	stateWith := func(powerState interface{}) tfsdk.State {
+		return tfsdk.State{
+			Schema: powerStateSchema,
+			Raw: tftypes.NewValue(powerStateSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"power_state": tftypes.NewValue(tftypes.String, powerState),
+			}),
+		}
+	}
+
+	cases := []struct {
+		name      string
+		itemState string
+		state     tfsdk.State
+		expected  string
+	}{
+		{"on", "on", stateWith("off"), "on"},
+		{"off", "off", stateWith("on"), "off"},
+		{"transitional state keeps prior off", "changing", stateWith("off"), "off"},
+		{"unknown state keeps prior on", "damaged", stateWith("on"), "on"},
+		{"unknown state without prior", "changing", stateWith(nil), "on"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if actual := ReadPowerState(ctx, c.itemState, c.state); actual.ValueString() != c.expected {
+				t.Errorf("ReadPowerState(%q) = %s, want %s", c.itemState, actual, c.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"terraform-provider-vtb/internal/client"
+	"terraform-provider-vtb/internal/consts"
+	"terraform-provider-vtb/pkg/client/sources"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidateFinancialProject проверяет на этапе plan, что источник финансирования
+// существует в проекте. Проверка выполняется при создании и смене источника,
+// чтобы ставший неактивным источник не ломал plan существующих заказов
+func ValidateFinancialProject(
+	ctx context.Context,
+	client *client.CloudClient,
+	req resource.ModifyPlanRequest,
+	planFinProject types.String,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if planFinProject.IsNull() || planFinProject.IsUnknown() {
+		return diags
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateFinProject types.String
+		diags.Append(req.State.GetAttribute(ctx, path.Root("financial_project"), &stateFinProject)...)
+		if diags.HasError() || stateFinProject.Equal(planFinProject) {
+			return diags
+		}
+	}
+
+	finProjects, err := sources.GetFinancialProjects(client.Creds, client.ProjectName, "")
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("financial_project"),
+			consts.MODIFY_PLAN_FAIL,
+			fmt.Sprintf("Can't get financial projects for project '%s'.\nError: %s", client.ProjectName, err.Error()),
+		)
+		return diags
+	}
+
+	available := make([]string, 0, len(finProjects))
+	for _, finProject := range finProjects {
+		if finProject.Name == planFinProject.ValueString() {
+			return diags
+		}
+		available = append(available, finProject.Name)
+	}
+
+	diags.AddAttributeError(
+		path.Root("financial_project"),
+		consts.MODIFY_PLAN_FAIL,
+		fmt.Sprintf(
+			"Financial project '%s' not found in project '%s'.\nAvailable financial projects: [%s]",
+			planFinProject.ValueString(),
+			client.ProjectName,
+			strings.Join(available, ", "),
+		),
+	)
+	return diags
+}
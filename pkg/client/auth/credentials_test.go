@@ -7,9 +7,20 @@ import (
 	"terraform-provider-vtb/pkg/client/env"
 )
 
-func TestNewCredentials(t *testing.T) {
+// loadServiceAccount учетные данные сервисного аккаунта, без них тест пропускается
+func loadServiceAccount(t *testing.T) *env.ServiceAccount {
+	t.Helper()
 
 	serviceAcc := env.Load()
+	if serviceAcc.ClientID == "" || serviceAcc.ClientSecret == "" {
+		t.Skip("Service Account Data is empty, test requires portal")
+	}
+	return serviceAcc
+}
+
+func TestNewCredentials(t *testing.T) {
+
+	serviceAcc := loadServiceAccount(t)
 
 	creds, err := NewCredentials(
 		serviceAcc.ClientID,
@@ -28,7 +39,7 @@ func TestNewCredentials(t *testing.T) {
 
 func TestUpdateToken(t *testing.T) {
 
-	serviceAcc := env.Load()
+	serviceAcc := loadServiceAccount(t)
 
 	creds, err := NewCredentials(
 		serviceAcc.ClientID,
@@ -0,0 +1,335 @@
+// Package cassette записывает HTTP взаимодействия клиента с порталом в yaml файлы
+// и воспроизводит их в тестах без доступа к порталу и учетных данных.
+//
+// С VTB_RECORD=1 запросы уходят на портал, а пары запрос/ответ сохраняются в кассету
+// с вычищенными токенами и паролями. По умолчанию ответы берутся из кассеты,
+// запрос сопоставляется по методу, URI и нормализованному телу.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Dir каталог кассет относительно каталога пакета с тестами
+const Dir = "testdata/cassettes"
+
+// scrubbed значение, которым заменяются секреты
+const scrubbed = "***"
+
+// StatusNotRecorded статус ответа на запрос, не найденный в кассете. Транспорт отвечает
+// сам, без ошибки, поэтому клиент не повторяет запрос, а тест получает ошибку статуса
+const StatusNotRecorded = http.StatusNotImplemented
+
+type Mode int
+
+const (
+	// Replay ответы берутся из кассеты, портал не вызывается
+	Replay Mode = iota
+	// Record запросы уходят на портал, взаимодействия записываются в кассету
+	Record
+)
+
+// ModeFromEnv режим работы кассет по переменной окружения VTB_RECORD
+func ModeFromEnv() Mode {
+	if os.Getenv("VTB_RECORD") == "1" {
+		return Record
+	}
+	return Replay
+}
+
+type Request struct {
+	Method string `yaml:"method"`
+	URI    string `yaml:"uri"`
+	Body   string `yaml:"body,omitempty"`
+}
+
+type Response struct {
+	Status  int                 `yaml:"status"`
+	Headers map[string][]string `yaml:"headers,omitempty"`
+	Body    string              `yaml:"body,omitempty"`
+}
+
+type Interaction struct {
+	Request  Request  `yaml:"request"`
+	Response Response `yaml:"response"`
+}
+
+// Cassette http.RoundTripper, записывающий или воспроизводящий взаимодействия
+type Cassette struct {
+	Interactions []Interaction `yaml:"interactions"`
+
+	// Transport транспорт для записи, nil - http.DefaultTransport
+	Transport http.RoundTripper `yaml:"-"`
+
+	path string
+	mode Mode
+	mu   sync.Mutex
+	used []bool
+}
+
+// Path путь к кассете теста с именем name
+func Path(name string) string {
+	replacer := strings.NewReplacer("/", "_", " ", "_", ":", "_")
+	return filepath.Join(Dir, replacer.Replace(name)+".yaml")
+}
+
+// Load открывает кассету. В режиме Replay файл кассеты должен существовать,
+// в режиме Record кассета начинается пустой и перезаписывается при Save
+func Load(path string, mode Mode) (*Cassette, error) {
+
+	c := &Cassette{path: path, mode: mode}
+	if mode == Record {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("can't parse cassette '%s': %w", path, err)
+	}
+	c.used = make([]bool, len(c.Interactions))
+	return c, nil
+}
+
+// Mode режим, в котором открыта кассета
+func (c *Cassette) Mode() Mode {
+	return c.mode
+}
+
+// Save записывает кассету на диск. В режиме Replay ничего не делает
+func (c *Cassette) Save() error {
+	if c.mode != Record {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, data, 0o644)
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+
+	recorded, err := newRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.mode == Record {
+		return c.record(req, recorded)
+	}
+	return c.replay(req, recorded)
+}
+
+func (c *Cassette) record(req *http.Request, recorded Request) (*http.Response, error) {
+
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	headers := map[string][]string{}
+	for _, key := range []string{"Content-Type", "X-Request-Id"} {
+		if values := resp.Header.Values(key); len(values) > 0 {
+			headers[key] = values
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, Interaction{
+		Request: recorded,
+		Response: Response{
+			Status:  resp.StatusCode,
+			Headers: headers,
+			Body:    scrubBody(string(body)),
+		},
+	})
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded Request) (*http.Response, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// одинаковые запросы (например, опрос статуса заказа) получают ответы в порядке записи
+	for i, interaction := range c.Interactions {
+		if c.used[i] || interaction.Request != recorded {
+			continue
+		}
+		c.used[i] = true
+
+		return newResponse(req, interaction.Response), nil
+	}
+	return notRecorded(req, fmt.Sprintf(
+		"request isn't recorded in cassette '%s': %s %s", c.path, recorded.Method, recorded.URI,
+	)), nil
+}
+
+// Offline транспорт, отклоняющий все запросы ответом StatusNotRecorded.
+// Подключается тестом явно через test.UseOffline
+type Offline struct{}
+
+func (Offline) RoundTrip(req *http.Request) (*http.Response, error) {
+	return notRecorded(req, fmt.Sprintf(
+		"request is sent in offline test: %s %s", req.Method, normalizeURI(req.URL),
+	)), nil
+}
+
+func notRecorded(req *http.Request, message string) *http.Response {
+	return newResponse(req, Response{
+		Status:  StatusNotRecorded,
+		Headers: map[string][]string{"Content-Type": {"text/plain"}},
+		Body:    message,
+	})
+}
+
+func newResponse(req *http.Request, response Response) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.Status, http.StatusText(response.Status)),
+		StatusCode:    response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header(response.Headers).Clone(),
+		Body:          io.NopCloser(strings.NewReader(response.Body)),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}
+}
+
+func newRequest(req *http.Request) (Request, error) {
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return Request{}, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	normalized := string(body)
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		normalized = normalizeForm(normalized)
+	} else {
+		normalized = scrubBody(normalized)
+	}
+
+	return Request{
+		Method: req.Method,
+		URI:    normalizeURI(req.URL),
+		Body:   normalized,
+	}, nil
+}
+
+// normalizeURI путь и отсортированные параметры запроса без схемы и хоста,
+// чтобы кассеты не зависели от стенда
+func normalizeURI(u *url.URL) string {
+	uri := u.EscapedPath()
+	if query := u.Query(); len(query) > 0 {
+		for key := range query {
+			if isSecret(key) {
+				query.Set(key, scrubbed)
+			}
+		}
+		uri += "?" + query.Encode()
+	}
+	return uri
+}
+
+func normalizeForm(body string) string {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return body
+	}
+	for key := range values {
+		if isSecret(key) {
+			values.Set(key, scrubbed)
+		}
+	}
+	return values.Encode()
+}
+
+// scrubBody JSON с отсортированными ключами и вычищенными секретами.
+// Тело, которое не является JSON, возвращается как есть
+func scrubBody(body string) string {
+	if strings.TrimSpace(body) == "" {
+		return ""
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+
+	normalized, err := json.Marshal(scrubValue(value))
+	if err != nil {
+		return body
+	}
+	return string(normalized)
+}
+
+func scrubValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if _, isString := nested.(string); isString && isSecret(key) {
+				v[key] = scrubbed
+				continue
+			}
+			v[key] = scrubValue(nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = scrubValue(nested)
+		}
+	}
+	return value
+}
+
+func isSecret(key string) bool {
+	key = strings.ToLower(key)
+	for _, secret := range []string{"token", "password", "secret"} {
+		if strings.Contains(key, secret) {
+			return true
+		}
+	}
+	return false
+}
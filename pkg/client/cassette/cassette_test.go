@@ -0,0 +1,120 @@
+package cassette
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func send(t *testing.T, transport http.RoundTripper, method, url, contentType, body string) (int, string, error) {
+	t.Helper()
+
+	client := http.Client{Transport: transport}
+	request, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Add("Content-Type", contentType)
+	request.Header.Add("Authorization", "Bearer live-access-token")
+
+	resp, err := client.Do(request)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, string(data), nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/token":
+			fmt.Fprint(w, `{"access_token":"live-access-token","expires_in":300}`)
+		case "/orders/1":
+			polls++
+			fmt.Fprintf(w, `{"id":"1","status":"%s","attrs":{"password":"qwerty","size":10}}`,
+				map[bool]string{true: "pending", false: "success"}[polls == 1])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), Path("TestRecordAndReplay"))
+
+	recorder, err := Load(path, Record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send(t, recorder, "POST", server.URL+"/auth/token", "application/x-www-form-urlencoded",
+		"client_secret=live-secret&client_id=sa")
+	send(t, recorder, "PATCH", server.URL+"/orders/1?page=1&per_page=10", "application/json",
+		`{"order":{"attrs":{"size":10,"mount":"/app"}}}`)
+	send(t, recorder, "GET", server.URL+"/orders/1", "application/json", "")
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("Save cassette: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"live-access-token", "live-secret", "qwerty", "Bearer"} {
+		if strings.Contains(string(data), secret) {
+			t.Fatalf("Cassette contains secret '%s':\n%s", secret, data)
+		}
+	}
+
+	// сервер больше не нужен: ответы берутся из кассеты
+	server.Close()
+	player, err := Load(path, Replay)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, body, err := send(t, player, "POST", "https://api.cloud.vtb.ru/auth/token",
+		"application/x-www-form-urlencoded", "client_id=sa&client_secret=other-secret")
+	if err != nil || body != `{"access_token":"***","expires_in":300}` {
+		t.Fatalf("Unexpected replayed token: %s, %v", body, err)
+	}
+
+	// порядок ключей и параметров запроса не влияет на сопоставление
+	status, body, err := send(t, player, "PATCH", "https://api.cloud.vtb.ru/orders/1?per_page=10&page=1",
+		"application/json", `{"order": {"attrs": {"mount": "/app", "size": 10}}}`)
+	if err != nil || status != http.StatusOK || !strings.Contains(body, `"status":"pending"`) {
+		t.Fatalf("Unexpected replayed response: %d %s, %v", status, body, err)
+	}
+
+	_, body, err = send(t, player, "GET", "https://api.cloud.vtb.ru/orders/1", "application/json", "")
+	if err != nil || !strings.Contains(body, `"status":"success"`) || !strings.Contains(body, `"password":"***"`) {
+		t.Fatalf("Unexpected replayed response: %s, %v", body, err)
+	}
+
+	status, body, err = send(t, player, "GET", "https://api.cloud.vtb.ru/orders/1", "application/json", "")
+	if err != nil || status != StatusNotRecorded || !strings.Contains(body, "GET /orders/1") {
+		t.Fatalf("Expected StatusNotRecorded for exhausted interaction, got %d %s, %v", status, body, err)
+	}
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "missing.yaml"), Replay)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("Expected not exist error, got %v", err)
+	}
+
+	status, body, err := send(t, Offline{}, "GET", "https://api.cloud.vtb.ru/orders", "application/json", "")
+	if err != nil || status != StatusNotRecorded || !strings.Contains(body, "GET /orders") {
+		t.Fatalf("Expected StatusNotRecorded from offline transport, got %d %s, %v", status, body, err)
+	}
+}
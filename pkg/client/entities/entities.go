@@ -216,9 +216,25 @@ type ServiceAccountRole struct {
 	UpdatedAt               string   `json:"updated_at"`
 }
 
+// IAMRole роль IAM. Name - полное имя роли, например organizations/vtb/roles/custom-role
+type IAMRole struct {
+	Name                    string   `json:"name"`
+	Title                   string   `json:"title"`
+	Description             string   `json:"description"`
+	Type                    string   `json:"type"`
+	Permissions             []string `json:"permissions"`
+	AvailableForCollections []string `json:"available_for_collections"`
+	CreatedAt               string   `json:"created_at"`
+	UpdatedAt               string   `json:"updated_at"`
+}
+
+// ApiKey ключ API сервисного аккаунта. ClientSecret приходит только при создании
+// аккаунта и перевыпуске ключа
 type ApiKey struct {
 	Name           string `json:"name"`
 	ServiceAccount string `json:"service_account"`
+	ClientId       string `json:"client_id"`
+	ClientSecret   string `json:"client_secret"`
 	CreatedAt      string `json:"created_at"`
 	UpdatedAt      string `json:"updated_at"`
 }
@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ItemDecoder декодирует config item'а продукта.
+// item - JSON item целиком, config - JSON поля data.config
+type ItemDecoder func(item, config json.RawMessage) (ProviderTyper, error)
+
+// ItemKind тип и провайдер item'а, для которых применяется декодер.
+// Пустой Provider подходит для любого провайдера,
+// провайдер вида "*balancer_v3*" подходит для провайдеров, содержащих "balancer_v3"
+type ItemKind struct {
+	Type     string
+	Provider string
+}
+
+func (k ItemKind) match(itemType, provider string) bool {
+	if k.Type != itemType {
+		return false
+	}
+	if k.Provider == "" {
+		return true
+	}
+	if strings.HasPrefix(k.Provider, "*") && strings.HasSuffix(k.Provider, "*") && len(k.Provider) > 1 {
+		return strings.Contains(provider, strings.Trim(k.Provider, "*"))
+	}
+	return k.Provider == provider
+}
+
+// Matches сообщает, подходит ли item под тип и провайдер k
+func (k ItemKind) Matches(item Item) bool {
+	return k.match(item.Type, item.Data.Provider)
+}
+
+type itemDecoder struct {
+	kind ItemKind
+	// decode nil для item'ов без config (graph, gslb anycast и т.п.)
+	decode ItemDecoder
+}
+
+// UnknownItemConfig config item'а, для типа и провайдера которого нет декодера.
+// Исходный JSON сохраняется, чтобы заказ с новым типом item'а читался целиком
+type UnknownItemConfig struct {
+	Type     string
+	Provider string
+	Raw      json.RawMessage
+}
+
+func (c UnknownItemConfig) GetProviderType() (string, string) {
+	return c.Provider, c.Type
+}
+
+func (c UnknownItemConfig) MarshalJSON() ([]byte, error) {
+	if len(c.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return c.Raw, nil
+}
+
+// itemDecoders проверяются по порядку, применяется первый подходящий
+var itemDecoders = []itemDecoder{
+	{ItemKind{"vm", ""}, decodeConfig[VMItemConfig]},
+	{ItemKind{"snapshot", ""}, decodeConfig[SnapshotItemConfig]},
+	{ItemKind{"cluster", "kafka"}, decodeConfig[KafkaItemConfig]},
+	{ItemKind{"cluster", "*kubernetes_v1*"}, decodeConfig[K8sClusterConfig]},
+	{ItemKind{"project", "kubernetes"}, decodeConfig[K8sProjectItemConfig]},
+	{ItemKind{"container_space", "kubernetes"}, decodeConfig[K8sContainerSpaceConfig]},
+	{ItemKind{"app", "wildfly"}, decodeConfig[WildflyItemConfig]},
+	{ItemKind{"app", "postgresql_v001"}, decodeConfig[PostgresqlItemConfig]},
+	{ItemKind{"cluster", "postgresql_v001"}, decodeConfig[PostgresqlItemConfig]},
+	{ItemKind{"db", "postgresql_v001"}, decodeConfig[PostgresqlDbItemConfig]},
+	{ItemKind{"slot", "postgresql_v001"}, decodeConfig[PostgresqlItemSlot]},
+	{ItemKind{"publication", "postgresql_v001"}, decodeConfig[PostgresqlItemPublication]},
+	{ItemKind{"app", "redis"}, decodeConfig[RedisItemConfig]},
+	{ItemKind{"cluster", "redis"}, decodeConfig[RedisItemConfig]},
+	{ItemKind{"app", "redis_sentinel"}, decodeConfig[RedisSentinelItemConfig]},
+	{ItemKind{"app", "clickhouse"}, decodeConfig[ClickHouseItemConfig]},
+	{ItemKind{"cluster", "clickhouse"}, decodeConfig[ClickhouseClusterItemConfig]},
+	{ItemKind{"app", "nginx"}, decodeConfig[NginxItemConfig]},
+	{ItemKind{"app", "nginx_develop"}, decodeConfig[NginxItemConfig]},
+	{ItemKind{"app", "agent_orchestration"}, decodeConfig[AgentOrchestrationItemConfig]},
+	{ItemKind{"cluster", "rabbitmq"}, decodeConfig[RabbitMQItemConfig]},
+	{ItemKind{"cluster", "rabbitmq_develop"}, decodeConfig[RabbitMQItemConfig]},
+	{ItemKind{"cluster", "vtb-artemis"}, decodeConfig[VTBArtemisItemConfig]},
+	// OpenMessaging Astra
+	{ItemKind{"app", "artemis"}, decodeConfig[OpenMessagingItemConfig]},
+	// OpenMessaging Astra LT
+	{ItemKind{"app", "artemis_lt"}, decodeConfig[OpenMessagingLtItemConfig]},
+	{ItemKind{"cluster", "debezium"}, decodeConfig[SyncXpertItemConfig]},
+	// TODO BALANCER сделать собственный анмаршалер айтема
+	{ItemKind{"cluster", "*balancer_v3*"}, decodeBalancerV3},
+	{ItemKind{"graph", ""}, nil},
+	{ItemKind{"cluster", "airflow"}, decodeConfig[AirflowClusterItemConfig]},
+	{ItemKind{"app", "airflow"}, decodeConfig[AirflowStandaloneItemConfig]},
+	{ItemKind{"cluster", "etcd"}, decodeConfig[EtcdlItemConfig]},
+	{ItemKind{"app", "grafana"}, decodeConfig[GrafanaItemConfig]},
+	{ItemKind{"cluster", "tarantool_v2"}, decodeConfig[TarantoolClusterItemConfig]},
+	{ItemKind{"saas", "rqaas"}, decodeConfig[RQaaSItemConfig]},
+	{ItemKind{"paas_ktaas", "ktaas"}, decodeConfig[KTaaSConfig]},
+	{ItemKind{"cluster", "elasticsearch_os"}, decodeConfig[ElasticSearchConfig]},
+	{ItemKind{"cluster", "scylladb"}, decodeConfig[ScyllaDbClusterItemConfig]},
+	{ItemKind{"cluster", "balancer"}, nil},
+	{ItemKind{"gslb_record", "v1_1"}, nil},
+	{ItemKind{"s3", "ceph"}, decodeConfig[S3CephTenantItemConfig]},
+	{ItemKind{"s3_bucket", "ceph"}, decodeConfig[S3CephBucketItemConfig]},
+	{ItemKind{"cluster", "*gslb_cluster_v1*"}, decodeGSLBV1},
+	{ItemKind{"gslb", "app_info"}, decodeConfig[GSLBAppItemConfig]},
+	{ItemKind{"gslb", "anycast"}, nil},
+	{ItemKind{"gslb", "bgpaas"}, nil},
+}
+
+// RegisterItemDecoder добавляет декодер для item'ов kind.
+// Зарегистрированный декодер проверяется раньше встроенных
+func RegisterItemDecoder(kind ItemKind, decode ItemDecoder) {
+	itemDecoders = append([]itemDecoder{{kind, decode}}, itemDecoders...)
+}
+
+// ItemKinds типы и провайдеры item'ов, для которых есть декодер
+func ItemKinds() []ItemKind {
+	kinds := make([]ItemKind, 0, len(itemDecoders))
+	for _, decoder := range itemDecoders {
+		kinds = append(kinds, decoder.kind)
+	}
+	return kinds
+}
+
+// IsKnownItem сообщает, есть ли декодер для item'а с типом itemType и провайдером provider
+func IsKnownItem(itemType, provider string) bool {
+	_, ok := findItemDecoder(itemType, provider)
+	return ok
+}
+
+func findItemDecoder(itemType, provider string) (itemDecoder, bool) {
+	for _, decoder := range itemDecoders {
+		if decoder.kind.match(itemType, provider) {
+			return decoder, true
+		}
+	}
+	return itemDecoder{}, false
+}
+
+func decodeConfig[T ProviderTyper](_, config json.RawMessage) (ProviderTyper, error) {
+	var c T
+	if err := json.Unmarshal(config, &c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func decodeBalancerV3(item, _ json.RawMessage) (ProviderTyper, error) {
+	var balancerV3 BalancerV3Item
+	if err := json.Unmarshal(item, &balancerV3); err != nil {
+		return nil, err
+	}
+	return balancerV3.Data, nil
+}
+
+func decodeGSLBV1(item, _ json.RawMessage) (ProviderTyper, error) {
+	var GSLBV1 GSLBV1Item
+	if err := json.Unmarshal(item, &GSLBV1); err != nil {
+		return nil, err
+	}
+	return GSLBV1.Data, nil
+}
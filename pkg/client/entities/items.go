@@ -3,7 +3,6 @@ package entities
 import (
 	"encoding/json"
 	"fmt"
-	"strings"
 )
 
 type ProviderTyper interface {
@@ -87,389 +86,36 @@ func (i *Item) UnmarshalJSON(data []byte) (err error) {
 		},
 	}
 
-	if i.Type == "vm" {
-		var vmConfig VMItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &vmConfig)
-		if err != nil {
-			return err
+	decoder, ok := findItemDecoder(i.Type, i.Data.Provider)
+	if !ok {
+		i.Data.Config = UnknownItemConfig{
+			Type:     i.Type,
+			Provider: i.Data.Provider,
+			Raw:      rawData.Data.Config,
 		}
-		i.Data.Config = vmConfig
 		return
 	}
-
-	if i.Type == "snapshot" {
-		var snapshotConfig SnapshotItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &snapshotConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = snapshotConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "kafka") {
-		var kafkaConfig KafkaItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &kafkaConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = kafkaConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (strings.Contains(i.Data.Provider, "kubernetes_v1")) {
-		var k8sClusterConfig K8sClusterConfig
-		err = json.Unmarshal(rawData.Data.Config, &k8sClusterConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = k8sClusterConfig
-		return
-	}
-
-	if (i.Type == "project") && (i.Data.Provider == "kubernetes") {
-		var k8sProjectConfig K8sProjectItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &k8sProjectConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = k8sProjectConfig
-		return
-	}
-
-	if (i.Type == "container_space") && (i.Data.Provider == "kubernetes") {
-		var k8sContainerSpaceConfig K8sContainerSpaceConfig
-		err = json.Unmarshal(rawData.Data.Config, &k8sContainerSpaceConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = k8sContainerSpaceConfig
-		return
-	}
-
-	if (i.Type == "app") && i.Data.Provider == "wildfly" {
-		var wildFlyConfig WildflyItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &wildFlyConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = wildFlyConfig
-		return
-	}
-
-	if (i.Type == "app" || i.Type == "cluster") && (i.Data.Provider == "postgresql_v001") {
-		var postgreConfig PostgresqlItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &postgreConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = postgreConfig
-		return
-	}
-
-	if (i.Type == "db") && (i.Data.Provider == "postgresql_v001") {
-		var dbConfig PostgresqlDbItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &dbConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = dbConfig
-		return
-	}
-
-	if (i.Type == "slot") && (i.Data.Provider == "postgresql_v001") {
-		var slotConfig PostgresqlItemSlot
-		err = json.Unmarshal(rawData.Data.Config, &slotConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = slotConfig
-		return
-	}
-
-	if (i.Type == "publication") && (i.Data.Provider == "postgresql_v001") {
-		var publicationConfig PostgresqlItemPublication
-		err = json.Unmarshal(rawData.Data.Config, &publicationConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = publicationConfig
-		return
-	}
-
-	if (i.Type == "app" || i.Type == "cluster") && (i.Data.Provider == "redis") {
-		var redisConfig RedisItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &redisConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = redisConfig
-		return
-	}
-
-	if (i.Type == "app") && (i.Data.Provider == "redis_sentinel") {
-		var redisConfig RedisSentinelItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &redisConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = redisConfig
-		return
-	}
-
-	if (i.Type == "app") && (i.Data.Provider == "clickhouse") {
-		var clickhouseConfig ClickHouseItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &clickhouseConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = clickhouseConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "clickhouse") {
-		var clickhouseClusterConfig ClickhouseClusterItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &clickhouseClusterConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = clickhouseClusterConfig
+	if decoder.decode == nil {
 		return
 	}
 
-	if (i.Type == "app") && (i.Data.Provider == "nginx_develop" || i.Data.Provider == "nginx") {
-		var nginxConfig NginxItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &nginxConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = nginxConfig
-		return
-	}
-
-	if (i.Type == "app") && (i.Data.Provider == "agent_orchestration") {
-		var agent_orchestrationConfig AgentOrchestrationItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &agent_orchestrationConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = agent_orchestrationConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "rabbitmq" || i.Data.Provider == "rabbitmq_develop") {
-		var rabbitConfig RabbitMQItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &rabbitConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = rabbitConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "vtb-artemis") {
-		var artemisConfig VTBArtemisItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &artemisConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = artemisConfig
-		return
-	}
-
-	// OpenMessaging Astra
-	if (i.Type == "app") && (i.Data.Provider == "artemis") {
-		var omConfig OpenMessagingItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &omConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = omConfig
-		return
-	}
-	// OpenMessaging Astra LT
-	if (i.Type == "app") && (i.Data.Provider == "artemis_lt") {
-		var omConfig OpenMessagingLtItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &omConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = omConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "debezium") {
-		var debeziumConfig SyncXpertItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &debeziumConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = debeziumConfig
-		return
-	}
-
-	// TODO BALANCER сделать собственный анмаршалер айтема
-	if (i.Type == "cluster") && (strings.Contains(i.Data.Provider, "balancer_v3")) {
-		var balancerV3 BalancerV3Item
-		err = json.Unmarshal(data, &balancerV3)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = balancerV3.Data
-		return
-	}
-
-	if i.Type == "graph" {
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "airflow") {
-		var airflowConfig AirflowClusterItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &airflowConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = airflowConfig
-		return
-	}
-
-	if (i.Type == "app") && (i.Data.Provider == "airflow") {
-		var airflowConfig AirflowStandaloneItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &airflowConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = airflowConfig
-		return
-
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "etcd") {
-		var etcdConfig EtcdlItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &etcdConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = etcdConfig
-		return
-	}
-
-	if (i.Type == "app") && (i.Data.Provider == "grafana") {
-		var grafanaConfig GrafanaItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &grafanaConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = grafanaConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "tarantool_v2") {
-		var tarantoolDataGridConfig TarantoolClusterItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &tarantoolDataGridConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = tarantoolDataGridConfig
-		return
-	}
-
-	if (i.Type == "saas") && (i.Data.Provider == "rqaas") {
-		var rqaasConfig RQaaSItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &rqaasConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = rqaasConfig
-		return
-	}
-
-	if (i.Type == "paas_ktaas") && (i.Data.Provider == "ktaas") {
-		var ktaaSConfig KTaaSConfig
-		err = json.Unmarshal(rawData.Data.Config, &ktaaSConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = ktaaSConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "elasticsearch_os") {
-		var elasticSearchConfig ElasticSearchConfig
-		err = json.Unmarshal(rawData.Data.Config, &elasticSearchConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = elasticSearchConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (i.Data.Provider == "scylladb") {
-		var scylladbclusterConfig ScyllaDbClusterItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &scylladbclusterConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = scylladbclusterConfig
-		return
-	}
-
-	if i.Type == "cluster" && (i.Data.Provider == "balancer") {
-		return
-	}
-
-	if i.Type == "gslb_record" && (i.Data.Provider == "v1_1") {
-		return
-	}
-
-	if (i.Type == "s3") && (i.Data.Provider == "ceph") {
-		var tenantConfig S3CephTenantItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &tenantConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = tenantConfig
-		return
-	}
-
-	if (i.Type == "s3_bucket") && (i.Data.Provider == "ceph") {
-		var bucketConfig S3CephBucketItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &bucketConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = bucketConfig
-		return
-	}
-
-	if (i.Type == "cluster") && (strings.Contains(i.Data.Provider, "gslb_cluster_v1")) {
-		var GSLBV1 GSLBV1Item
-		err = json.Unmarshal(data, &GSLBV1)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = GSLBV1.Data
-		return
-	}
-
-	if (i.Type == "gslb") && (i.Data.Provider == "app_info") {
-		var GSLBAppInfoConfig GSLBAppItemConfig
-		err = json.Unmarshal(rawData.Data.Config, &GSLBAppInfoConfig)
-		if err != nil {
-			return err
-		}
-		i.Data.Config = GSLBAppInfoConfig
-		return
-	}
-
-	if i.Type == "gslb" && (i.Data.Provider == "anycast") {
-		return
-	}
-
-	if i.Type == "gslb" && (i.Data.Provider == "bgpaas") {
-		return
+	i.Data.Config, err = decoder.decode(data, rawData.Data.Config)
+	if err != nil {
+		return fmt.Errorf("can't unmarshal item, type=%s, provider=%s: %w", i.Type, i.Data.Provider, err)
 	}
-
-	err = fmt.Errorf("can't unmarshal item, type=%s, provider=%s", i.Type, i.Data.Provider)
 	return
 }
+
+// ItemConfig config item'а с типом T. Для item'а с config другого типа, например
+// UnknownItemConfig незнакомого провайдера, возвращает ошибку вместо паники
+func ItemConfig[T ProviderTyper](item *Item) (T, error) {
+	config, ok := item.Data.Config.(T)
+	if !ok {
+		var expected T
+		return expected, fmt.Errorf(
+			"item '%s' (type=%s, provider=%s) has config %T, expected %T",
+			item.ID, item.Type, item.Data.Provider, item.Data.Config, expected,
+		)
+	}
+	return config, nil
+}
@@ -0,0 +1,159 @@
+package entities
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// go test ./pkg/client/entities -run TestItemFixtures -update
+var update = flag.Bool("update", false, "rewrite golden files of item fixtures")
+
+// Образцы item'ов лежат в testdata/items/<продукт>.json, рядом с ними golden файлы
+// с типом и содержимым декодированного config
+const itemFixtures = "testdata/items"
+
+func readItemFixtures(t *testing.T) map[string][]byte {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(itemFixtures, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("No item fixtures in %s", itemFixtures)
+	}
+
+	fixtures := map[string][]byte{}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fixtures[path] = data
+	}
+	return fixtures
+}
+
+func TestItemFixtures(t *testing.T) {
+	for path, data := range readItemFixtures(t) {
+		path, data := path, data
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			var item Item
+			if err := json.Unmarshal(data, &item); err != nil {
+				t.Fatalf("Can't decode item: %v", err)
+			}
+			if _, unknown := item.Data.Config.(UnknownItemConfig); unknown {
+				t.Fatalf(
+					"Portal item type=%s provider=%s isn't recognised, add decoder to itemDecoders",
+					item.Type, item.Data.Provider,
+				)
+			}
+
+			var buffer bytes.Buffer
+			encoder := json.NewEncoder(&buffer)
+			encoder.SetEscapeHTML(false)
+			encoder.SetIndent("", "  ")
+			err := encoder.Encode(struct {
+				ConfigType string        `json:"config_type"`
+				Config     ProviderTyper `json:"config"`
+			}{
+				ConfigType: fmt.Sprintf("%T", item.Data.Config),
+				Config:     item.Data.Config,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded := buffer.Bytes()
+
+			goldenPath := strings.TrimSuffix(path, ".json") + ".golden"
+			if *update {
+				if err := os.WriteFile(goldenPath, decoded, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			golden, err := os.ReadFile(goldenPath)
+			if err != nil {
+				t.Fatalf("Can't read golden file, run test with -update: %v", err)
+			}
+			if string(golden) != string(decoded) {
+				t.Fatalf("Decoded item doesn't match %s:\n%s", goldenPath, decoded)
+			}
+		})
+	}
+}
+
+func TestItemDecodersHaveFixtures(t *testing.T) {
+	var items []Item
+	for _, data := range readItemFixtures(t) {
+		var item Item
+		if err := json.Unmarshal(data, &item); err == nil {
+			items = append(items, item)
+		}
+	}
+
+	for _, kind := range ItemKinds() {
+		found := false
+		for _, item := range items {
+			if kind.match(item.Type, item.Data.Provider) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Item type=%s provider=%s has decoder but no fixture in %s", kind.Type, kind.Provider, itemFixtures)
+		}
+	}
+}
+
+func TestUnknownItemKeepsRawConfig(t *testing.T) {
+	data := `{
+		"item_id": "item-id",
+		"type": "cluster",
+		"data": {"state": "on", "provider": "kafka_v2", "config": {"cluster_name": "kafka-01"}}
+	}`
+
+	var item Item
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatalf("Unknown item must be decoded without error: %v", err)
+	}
+	config, ok := item.Data.Config.(UnknownItemConfig)
+	if !ok {
+		t.Fatalf("Expected UnknownItemConfig, got %T", item.Data.Config)
+	}
+	if provider, itemType := config.GetProviderType(); provider != "kafka_v2" || itemType != "cluster" {
+		t.Fatalf("Unexpected provider and type: %s, %s", provider, itemType)
+	}
+	if string(config.Raw) != `{"cluster_name": "kafka-01"}` {
+		t.Fatalf("Raw config wasn't kept: %s", config.Raw)
+	}
+	if IsKnownItem("cluster", "kafka_v2") || !IsKnownItem("cluster", "kafka") {
+		t.Fatal("IsKnownItem doesn't match decoders")
+	}
+}
+
+func TestItemConfigOfUnknownItem(t *testing.T) {
+	data := `{"item_id": "item-1", "type": "cluster", "data": {"provider": "kafka_v2", "config": {"cluster_name": "kafka-01"}}}`
+
+	var item Item
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatalf("Unknown item must be decoded without error: %v", err)
+	}
+	_, err := ItemConfig[KafkaItemConfig](&item)
+	if err == nil || !strings.Contains(err.Error(), "provider=kafka_v2") {
+		t.Fatalf("Expected error for unknown item config, got %v", err)
+	}
+
+	item.Data.Config = KafkaItemConfig{ClusterName: "kafka-01"}
+	config, err := ItemConfig[KafkaItemConfig](&item)
+	if err != nil || config.ClusterName != "kafka-01" {
+		t.Fatalf("Unexpected config %v, %v", config, err)
+	}
+}
@@ -0,0 +1,144 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"terraform-provider-vtb/pkg/client/entities"
+)
+
+// ServiceAccount сервисный аккаунт проекта. Секрет ключа API хранится,
+// но отдается только в ответе на создание
+type ServiceAccount struct {
+	entities.ServiceAccount
+	Project string
+}
+
+func (a *ServiceAccount) copy() *ServiceAccount {
+	c := *a
+	c.Roles = append([]entities.ServiceAccountRole(nil), a.Roles...)
+	return &c
+}
+
+// withoutSecret аккаунт в формате чтения: без client_secret
+func (a *ServiceAccount) withoutSecret() entities.ServiceAccount {
+	c := a.ServiceAccount
+	c.ApiKey.ClientSecret = ""
+	return c
+}
+
+// ServiceAccount копия сервисного аккаунта проекта
+func (s *Server) ServiceAccount(project, name string) (*ServiceAccount, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	account, ok := s.serviceAccounts[project+"/"+name]
+	if !ok {
+		return nil, false
+	}
+	return account.copy(), true
+}
+
+func (s *Server) serveServiceAccounts(w http.ResponseWriter, r *http.Request, project string, body []byte) {
+
+	switch r.Method {
+	case http.MethodGet:
+		list := make([]entities.ServiceAccount, 0)
+		for _, key := range s.serviceAccountKeys {
+			if account := s.serviceAccounts[key]; account.Project == project {
+				list = append(list, account.withoutSecret())
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": list,
+			"meta": map[string]interface{}{"total_count": len(list)},
+		})
+
+	case http.MethodPost:
+		now := time.Now().UTC().Format(time.RFC3339)
+		account := &ServiceAccount{Project: project}
+		account.Name = fmt.Sprintf("sa-%s", newID()[:8])
+		account.CreatedAt = now
+		if err := applyServiceAccount(account, body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		account.ApiKey = entities.ApiKey{
+			Name:           account.Name + "-key",
+			ServiceAccount: account.Name,
+			ClientId:       account.Name,
+			ClientSecret:   newID(),
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
+		key := project + "/" + account.Name
+		s.serviceAccounts[key] = account
+		s.serviceAccountKeys = append(s.serviceAccountKeys, key)
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"data": account.ServiceAccount})
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (s *Server) serveServiceAccount(w http.ResponseWriter, r *http.Request, project, name string, body []byte) {
+
+	key := project + "/" + name
+	account, ok := s.serviceAccounts[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("service account '%s' not found", name))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": account.withoutSecret()})
+
+	case http.MethodPatch:
+		if err := applyServiceAccount(account, body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": account.withoutSecret()})
+
+	case http.MethodDelete:
+		delete(s.serviceAccounts, key)
+		for i, k := range s.serviceAccountKeys {
+			if k == key {
+				s.serviceAccountKeys = append(s.serviceAccountKeys[:i], s.serviceAccountKeys[i+1:]...)
+				break
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+// applyServiceAccount применяет название и роли из payload создания или обновления
+func applyServiceAccount(account *ServiceAccount, body []byte) error {
+	var payload struct {
+		ServiceAccount struct {
+			Title  string `json:"title"`
+			Policy struct {
+				Bindings []struct {
+					Role string `json:"role"`
+				} `json:"bindings"`
+			} `json:"policy"`
+		} `json:"service_account"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return err
+	}
+
+	account.Title = payload.ServiceAccount.Title
+	account.Roles = []entities.ServiceAccountRole{}
+	for _, binding := range payload.ServiceAccount.Policy.Bindings {
+		account.Roles = append(account.Roles, entities.ServiceAccountRole{Name: binding.Role})
+	}
+	account.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+	return nil
+}
@@ -0,0 +1,468 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"terraform-provider-vtb/pkg/client/entities"
+)
+
+// Item item заказа в формате order-service
+type Item struct {
+	ID      string   `json:"item_id"`
+	OrderID string   `json:"order_id"`
+	Type    string   `json:"type"`
+	Data    ItemData `json:"data"`
+}
+
+type ItemData struct {
+	State    string                 `json:"state"`
+	Parent   string                 `json:"parent"`
+	Provider string                 `json:"provider"`
+	ACLs     []entities.AccessACL   `json:"acls"`
+	Build    map[string]interface{} `json:"build"`
+	Config   map[string]interface{} `json:"config"`
+}
+
+type LastAction struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Name   string `json:"name"`
+	output string
+}
+
+// Order заказ в формате order-service
+type Order struct {
+	ID              string                   `json:"id"`
+	Label           string                   `json:"label"`
+	Status          string                   `json:"status"`
+	ProductID       string                   `json:"product_id"`
+	ProjectName     string                   `json:"project_name"`
+	CreatedAt       string                   `json:"created_at"`
+	UpdatedAt       string                   `json:"updated_at"`
+	Lifetime        string                   `json:"lifetime,omitempty"`
+	Deletable       bool                     `json:"deletable"`
+	Attrs           map[string]interface{}   `json:"attrs"`
+	LastAction      LastAction               `json:"last_action"`
+	FinancialSource entities.FinancialSource `json:"financial_source"`
+	Items           []*Item                  `json:"data"`
+
+	pendingPolls int
+	pending      func() error
+	finalStatus  string
+}
+
+// ParentItem item заказа без родителя
+func (o *Order) ParentItem() *Item {
+	for _, item := range o.Items {
+		if item.Data.Parent == "" {
+			return item
+		}
+	}
+	return nil
+}
+
+// AddItem добавляет в заказ дочерний item
+func (o *Order) AddItem(itemType, provider string, config map[string]interface{}) *Item {
+	parent := ""
+	if item := o.ParentItem(); item != nil {
+		parent = item.ID
+	}
+	item := &Item{
+		ID:      newID(),
+		OrderID: o.ID,
+		Type:    itemType,
+		Data: ItemData{
+			State:    "on",
+			Parent:   parent,
+			Provider: provider,
+			Build:    map[string]interface{}{"setup_version": "1.0.0"},
+			Config:   config,
+		},
+	}
+	o.Items = append(o.Items, item)
+	return item
+}
+
+func (o *Order) item(itemID string) *Item {
+	for _, item := range o.Items {
+		if item.ID == itemID {
+			return item
+		}
+	}
+	return o.ParentItem()
+}
+
+func (o *Order) copy() *Order {
+	var result Order
+	content, _ := json.Marshal(o)
+	json.Unmarshal(content, &result)
+	return &result
+}
+
+// Product описание продукта: тип и provider родительского item
+// и функция, которая строит items заказа по attrs
+type Product struct {
+	ItemType string
+	Provider string
+
+	// Items строит items после выполнения заказа. По умолчанию создается
+	// один родительский item, конфиг которого равен attrs заказа
+	Items func(order *Order, attrs map[string]interface{}) error
+
+	// Actions обработчики действий продукта, добавляются к Server.Actions в AddProduct
+	Actions map[string]ActionFunc
+
+	// DeleteActions действия удаления заказа, имя которых не начинается с delete
+	DeleteActions []string
+}
+
+// ActionCall вызов действия заказа
+type ActionCall struct {
+	OrderID string
+	Name    string
+	ItemID  string
+	Attrs   map[string]interface{}
+	Payload map[string]interface{}
+}
+
+// ActionFunc применяет действие к заказу. Ошибка завершает действие статусом error
+type ActionFunc func(order *Order, item *Item, attrs map[string]interface{}) error
+
+func (s *Server) serveOrders(w http.ResponseWriter, r *http.Request, projectName string, body []byte) {
+
+	switch r.Method {
+	case http.MethodGet:
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+		if page < 1 {
+			page = 1
+		}
+		if perPage < 1 {
+			perPage = 10
+		}
+
+		var projectOrders []*Order
+		for _, id := range s.ordered {
+			if order := s.orders[id]; order.ProjectName == projectName {
+				projectOrders = append(projectOrders, order)
+			}
+		}
+
+		list := make([]*Order, 0)
+		for i := (page - 1) * perPage; i < len(projectOrders) && i < page*perPage; i++ {
+			list = append(list, projectOrders[i])
+		}
+		writeList(w, list, len(projectOrders))
+
+	case http.MethodPost:
+		var payload struct {
+			Order struct {
+				Label              string                 `json:"label"`
+				Attrs              map[string]interface{} `json:"attrs"`
+				ProductID          string                 `json:"product_id"`
+				Count              int                    `json:"count"`
+				FinancialProjectID string                 `json:"financial_project_id"`
+				Lifetime           string                 `json:"lifetime"`
+			} `json:"order"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		product, ok := s.Products[payload.Order.ProductID]
+		if !ok {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown product_id '%s'", payload.Order.ProductID))
+			return
+		}
+
+		now := time.Now().UTC().Format(time.RFC3339)
+		order := &Order{
+			ID:          newID(),
+			Label:       payload.Order.Label,
+			Status:      "pending",
+			ProductID:   payload.Order.ProductID,
+			ProjectName: projectName,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Lifetime:    payload.Order.Lifetime,
+			Deletable:   true,
+			Attrs:       payload.Order.Attrs,
+			Items:       []*Item{},
+		}
+		for _, fp := range s.FinancialProjects {
+			if fp.ID == payload.Order.FinancialProjectID {
+				order.FinancialSource = entities.FinancialSource{Name: fp.Name, Code: fp.Code, SourceType: fp.Type}
+			}
+		}
+
+		s.orders[order.ID] = order
+		s.ordered = append(s.ordered, order.ID)
+		s.calls = append(s.calls, ActionCall{
+			OrderID: order.ID,
+			Name:    "create",
+			Attrs:   payload.Order.Attrs,
+		})
+
+		s.startAction(order, "create", "success", func() error {
+			if product.Items != nil {
+				return product.Items(order, payload.Order.Attrs)
+			}
+			order.Items = append(order.Items, &Item{
+				ID:      newID(),
+				OrderID: order.ID,
+				Type:    product.ItemType,
+				Data: ItemData{
+					State:    "on",
+					Provider: product.Provider,
+					Build:    map[string]interface{}{"setup_version": "1.0.0"},
+					Config:   withoutTags(payload.Order.Attrs),
+				},
+			})
+			return nil
+		})
+		writeJSON(w, http.StatusCreated, []*Order{order})
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (s *Server) serveOrder(w http.ResponseWriter, r *http.Request, orderID string, body []byte) {
+
+	order, ok := s.orders[orderID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("order '%s' not found", orderID))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.poll(order)
+		writeJSON(w, http.StatusOK, order)
+
+	case http.MethodPatch:
+		var payload struct {
+			Order struct {
+				Label string `json:"label"`
+			} `json:"order"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		order.Label = payload.Order.Label
+		order.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+		writeJSON(w, http.StatusOK, order)
+
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+	}
+}
+
+func (s *Server) serveOrderFinProjects(w http.ResponseWriter, r *http.Request, orderID string, body []byte) {
+
+	order, ok := s.orders[orderID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("order '%s' not found", orderID))
+		return
+	}
+
+	var payload struct {
+		OrderFinProjects []struct {
+			FinancialProjectID string `json:"financial_project_id"`
+		} `json:"order_fin_projects"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || len(payload.OrderFinProjects) == 0 {
+		writeError(w, http.StatusBadRequest, "order_fin_projects is required")
+		return
+	}
+
+	for _, fp := range s.FinancialProjects {
+		if fp.ID == payload.OrderFinProjects[0].FinancialProjectID {
+			order.FinancialSource = entities.FinancialSource{Name: fp.Name, Code: fp.Code, SourceType: fp.Type}
+			writeJSON(w, http.StatusOK, order)
+			return
+		}
+	}
+	writeError(w, http.StatusBadRequest, "unknown financial project")
+}
+
+func (s *Server) serveOrderAction(w http.ResponseWriter, r *http.Request, orderID, name string, body []byte) {
+
+	order, ok := s.orders[orderID]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("order '%s' not found", orderID))
+		return
+	}
+	if r.Method != http.MethodPatch {
+		writeError(w, http.StatusMethodNotAllowed, r.Method)
+		return
+	}
+	if order.pending != nil {
+		writeError(w, http.StatusConflict, fmt.Sprintf("order '%s' has action in progress", orderID))
+		return
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	itemID, _ := payload["item_id"].(string)
+	var attrs map[string]interface{}
+	if orderPayload, ok := payload["order"].(map[string]interface{}); ok {
+		attrs, _ = orderPayload["attrs"].(map[string]interface{})
+	}
+
+	item := order.item(itemID)
+	if item == nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("order '%s' has no items", orderID))
+		return
+	}
+
+	s.calls = append(s.calls, ActionCall{
+		OrderID: orderID,
+		Name:    name,
+		ItemID:  item.ID,
+		Attrs:   attrs,
+		Payload: payload,
+	})
+
+	finalStatus := "success"
+	if s.isDeleteAction(name) {
+		finalStatus = "deprovisioned"
+	}
+	s.startAction(order, name, finalStatus, func() error {
+		return s.applyAction(order, item, name, attrs)
+	})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"id": order.LastAction.ID})
+}
+
+func (s *Server) serveActionOutput(w http.ResponseWriter, r *http.Request, orderID, actionID string) {
+
+	order, ok := s.orders[orderID]
+	if !ok || order.LastAction.ID != actionID {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("action '%s' not found", actionID))
+		return
+	}
+
+	list := []map[string]interface{}{
+		{"type": "text", "status": order.LastAction.Status, "data": order.LastAction.output},
+	}
+	writeList(w, list, len(list))
+}
+
+// startAction переводит заказ в pending. Действие применяется,
+// когда заказ запросят PendingPolls+1 раз
+func (s *Server) startAction(order *Order, name, finalStatus string, apply func() error) {
+
+	s.applyStatus(order, name, finalStatus)
+	order.LastAction = LastAction{ID: newID(), Name: name, Status: "pending"}
+	order.pendingPolls = s.PendingPolls
+	order.finalStatus = finalStatus
+
+	output, fail := s.failures[name]
+	delete(s.failures, name)
+	order.pending = func() error {
+		if fail {
+			return fmt.Errorf("%s", output)
+		}
+		return apply()
+	}
+}
+
+func (s *Server) applyStatus(order *Order, name, finalStatus string) {
+	switch {
+	case name == "create":
+		order.Status = "pending"
+	case finalStatus == "deprovisioned":
+		order.Status = "removing"
+	default:
+		order.Status = "changing"
+	}
+}
+
+func (s *Server) poll(order *Order) {
+	if order.pending == nil {
+		return
+	}
+	if order.pendingPolls > 0 {
+		order.pendingPolls--
+		return
+	}
+
+	err := order.pending()
+	order.pending = nil
+	order.UpdatedAt = time.Now().UTC().Format(time.RFC3339)
+
+	if err != nil {
+		order.LastAction.Status = "error"
+		order.LastAction.output = err.Error()
+		if order.Status == "pending" {
+			order.Status = "damaged"
+		} else {
+			order.Status = "success"
+		}
+		return
+	}
+	order.LastAction.Status = "success"
+	order.Status = order.finalStatus
+}
+
+// applyAction применяет действие к item. Без зарегистрированного обработчика:
+// delete* удаляет items заказа, start* и stop* меняют state, остальные действия
+// дописывают attrs в конфиг item
+func (s *Server) applyAction(order *Order, item *Item, name string, attrs map[string]interface{}) error {
+
+	if action, ok := s.Actions[name]; ok {
+		return action(order, item, attrs)
+	}
+
+	switch {
+	case s.isDeleteAction(name):
+		for _, it := range order.Items {
+			it.Data.State = "deleted"
+		}
+	case strings.HasPrefix(name, "start"):
+		item.Data.State = "on"
+	case strings.HasPrefix(name, "stop"):
+		item.Data.State = "off"
+	default:
+		if item.Data.Config == nil {
+			item.Data.Config = map[string]interface{}{}
+		}
+		for key, value := range withoutTags(attrs) {
+			item.Data.Config[key] = value
+		}
+	}
+	return nil
+}
+
+// isDeleteAction действие удаляет заказ целиком. Действие delete* с обработчиком
+// удаляет только свой item, например снимок ВМ
+func (s *Server) isDeleteAction(name string) bool {
+	if s.deleteActions[name] {
+		return true
+	}
+	_, handled := s.Actions[name]
+	return strings.HasPrefix(name, "delete") && !handled
+}
+
+// withoutTags attrs без служебных тегов, которые провайдер добавляет в каждое действие
+func withoutTags(attrs map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(attrs))
+	for key, value := range attrs {
+		if key == "created_with_opentofu" {
+			continue
+		}
+		result[key] = value
+	}
+	return result
+}
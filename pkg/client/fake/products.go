@@ -0,0 +1,497 @@
+package fake
+
+import (
+	"fmt"
+	"strconv"
+
+	"terraform-provider-vtb/pkg/client/entities"
+)
+
+// ComputeProduct продукт с одной ВМ, конфиг которой строится по attrs заказа
+// так же, как на портале: flavor, образ, точки монтирования и группы доступа
+func ComputeProduct(distribution string) Product {
+	return Product{
+		ItemType: "vm",
+		Provider: "vsphere",
+		Items: func(order *Order, attrs map[string]interface{}) error {
+			vm := order.AddItem("vm", "vsphere", VMConfig(len(order.Items)+1, distribution, attrs))
+			vm.Data.ACLs = vmACLs(attrs)
+			return nil
+		},
+	}
+}
+
+// VMConfig конфиг item ВМ в формате entities.VMItemConfig
+func VMConfig(index int, distribution string, attrs map[string]interface{}) map[string]interface{} {
+
+	defaultNic, _ := attrs["default_nic"].(map[string]interface{})
+	netSegment, _ := defaultNic["net_segment"].(string)
+
+	extraMounts := []interface{}{}
+	if mounts, ok := attrs["extra_mounts"].([]interface{}); ok {
+		for _, m := range mounts {
+			mount, _ := m.(map[string]interface{})
+			extraMounts = append(extraMounts, map[string]interface{}{
+				"mount":  mount["path"],
+				"size":   mount["size"],
+				"fstype": mount["file_system"],
+			})
+		}
+	}
+
+	return map[string]interface{}{
+		"hostname":    fmt.Sprintf("fake-vm-%02d", index),
+		"domain":      attrs["domain"],
+		"on_support":  attrs["on_support"],
+		"os_version":  attrs["os_version"],
+		"environment": "DEV",
+		"flavor":      attrs["flavor"],
+		"default_nic": map[string]interface{}{
+			"net_segment": netSegment,
+			"addresses": []interface{}{
+				map[string]interface{}{"type": "ipv4", "address": fmt.Sprintf("10.0.0.%d", index+10)},
+			},
+		},
+		"image": map[string]interface{}{
+			"os": map[string]interface{}{
+				"distribution": distribution,
+				"version":      attrs["os_version"],
+			},
+		},
+		"extra_mounts": extraMounts,
+	}
+}
+
+// KafkaProduct кластер kafka из одной ВМ с ролями kafka и zookeeper (layout one_dc:kafka:zookeeper).
+// Топики хранятся в конфиге item cluster в формате портала (числа строками)
+// и меняются действиями kafka_create_topics, kafka_edit_topics_release и kafka_delete_topics.
+// ACL доступа к топикам меняются действиями kafka_create_acls и kafka_delete_acls.
+// Квоты создаются и меняются действием kafka_create_quotas, удаляются kafka_delete_quotas
+func KafkaProduct(distribution string) Product {
+	return Product{
+		ItemType: "cluster",
+		Provider: "kafka",
+		Items: func(order *Order, attrs map[string]interface{}) error {
+			retention, ok := attrs["kafka_log_retention_minutes"]
+			if !ok {
+				retention = float64(30)
+			}
+			order.AddItem("cluster", "kafka", map[string]interface{}{
+				"cluster_name":                attrs["cluster_name"],
+				"kafka_version":               attrs["kafka_version"],
+				"kafka_log_retention_minutes": retention,
+				"connection_url":              fmt.Sprintf("fake-vm-02.%v:9092", attrs["domain"]),
+				"topics":                      []interface{}{},
+				"acls":                        []interface{}{},
+				"transaction_acls":            []interface{}{},
+				"idempotent_acls":             []interface{}{},
+				"group_acls":                  []interface{}{},
+				"quotas":                      []interface{}{},
+			})
+
+			vmAttrs := map[string]interface{}{}
+			for key, value := range attrs {
+				vmAttrs[key] = value
+			}
+			if flavor, ok := attrs["one_node_flavor"]; ok {
+				vmAttrs["flavor"] = flavor
+			}
+			config := VMConfig(len(order.Items)+1, distribution, vmAttrs)
+			config["node_roles"] = []interface{}{"kafka", "zookeeper"}
+			vm := order.AddItem("vm", "vsphere", config)
+			vm.Data.ACLs = vmACLs(attrs)
+			return nil
+		},
+		Actions: map[string]ActionFunc{
+			"kafka_create_topics": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				topics, _ := item.Data.Config["topics"].([]interface{})
+				created, _ := attrs["topics"].([]interface{})
+				for _, t := range created {
+					topic, _ := t.(map[string]interface{})
+					stored := map[string]interface{}{"segment_bytes": "1073741824"}
+					for key, value := range topic {
+						stored[key] = portalValue(value)
+					}
+					delete(stored, "_cleanup^limit_by")
+					topics = append(topics, stored)
+				}
+				item.Data.Config["topics"] = topics
+				return nil
+			},
+			"kafka_edit_topics_release": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				stored, _ := item.Data.Config["topics"].([]interface{})
+				changes, _ := attrs["changes"].([]interface{})
+				for _, c := range changes {
+					change, _ := c.(map[string]interface{})
+					params, _ := change["parameters"].(map[string]interface{})
+					for _, name := range toStrings(change["topic_names"]) {
+						topic := findTopic(stored, name)
+						if topic == nil {
+							return fmt.Errorf("topic '%s' not found", name)
+						}
+						editTopic(topic, params)
+					}
+				}
+				return nil
+			},
+			"kafka_delete_topics": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				deleted := toStrings(attrs["topics"])
+				topics := []interface{}{}
+				stored, _ := item.Data.Config["topics"].([]interface{})
+				for _, t := range stored {
+					topic, _ := t.(map[string]interface{})
+					name, _ := topic["topic_name"].(string)
+					if !contains(deleted, name) {
+						topics = append(topics, topic)
+					}
+				}
+				item.Data.Config["topics"] = topics
+				return nil
+			},
+			"kafka_create_acls": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				acls, _ := item.Data.Config["acls"].([]interface{})
+				created, _ := attrs["acls"].([]interface{})
+				for _, a := range created {
+					acl, _ := a.(map[string]interface{})
+					i := findTopicACL(acls, acl)
+					if i < 0 {
+						stored := map[string]interface{}{}
+						for key, value := range acl {
+							stored[key] = value
+						}
+						acls = append(acls, stored)
+						continue
+					}
+					stored := acls[i].(map[string]interface{})
+					names := toStrings(stored["topic_names"])
+					for _, name := range toStrings(acl["topic_names"]) {
+						if !contains(names, name) {
+							names = append(names, name)
+						}
+					}
+					stored["topic_names"] = names
+				}
+				item.Data.Config["acls"] = acls
+				return nil
+			},
+			"kafka_delete_acls": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				acls, _ := item.Data.Config["acls"].([]interface{})
+				deleted, _ := attrs["acls"].([]interface{})
+				for _, d := range deleted {
+					acl, _ := d.(map[string]interface{})
+					i := findTopicACL(acls, acl)
+					if i < 0 {
+						return fmt.Errorf("ACL %v not found", acl)
+					}
+					stored := acls[i].(map[string]interface{})
+					names := []string{}
+					for _, name := range toStrings(stored["topic_names"]) {
+						if !contains(toStrings(acl["topic_names"]), name) {
+							names = append(names, name)
+						}
+					}
+					if acl["topic_type"] == "by_name" && len(names) > 0 {
+						stored["topic_names"] = names
+						continue
+					}
+					acls = append(acls[:i:i], acls[i+1:]...)
+				}
+				item.Data.Config["acls"] = acls
+				return nil
+			},
+			"kafka_create_quotas": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				quotas, _ := item.Data.Config["quotas"].([]interface{})
+				created, _ := attrs["quotas"].([]interface{})
+				for _, q := range created {
+					bulk, _ := q.(map[string]interface{})
+					clientCNs := toStrings(bulk["client_cns"])
+					if bulk["quota_type"] == "default" {
+						clientCNs = []string{"<default>"}
+					}
+					for _, clientCN := range clientCNs {
+						quota := map[string]interface{}{"client_cn": clientCN, "producer_byte_rate": bulk["producer_byte_rate"]}
+						if i := findQuota(quotas, clientCN); i >= 0 {
+							quotas[i] = quota
+						} else {
+							quotas = append(quotas, quota)
+						}
+					}
+				}
+				item.Data.Config["quotas"] = quotas
+				return nil
+			},
+			"kafka_delete_quotas": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				quotas, _ := item.Data.Config["quotas"].([]interface{})
+				deleted, _ := attrs["quotas"].([]interface{})
+				for _, q := range deleted {
+					bulk, _ := q.(map[string]interface{})
+					for _, clientCN := range toStrings(bulk["client_cns"]) {
+						i := findQuota(quotas, clientCN)
+						if i < 0 {
+							return fmt.Errorf("quota for '%s' not found", clientCN)
+						}
+						quotas = append(quotas[:i:i], quotas[i+1:]...)
+					}
+				}
+				item.Data.Config["quotas"] = quotas
+				return nil
+			},
+			"resize_kafka_cluster_vms": func(order *Order, item *Item, attrs map[string]interface{}) error {
+				for _, it := range order.Items {
+					if it.Type == "vm" {
+						it.Data.Config["flavor"] = attrs["flavor"]
+					}
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// K8sClusterProduct кластер kubernetes_v1 версии 1.<minor> с одним регионом и одним ingress.
+// Размеры и flavor региона и ingress берутся из attrs worker и ingress, control plane
+// по умолчанию: master, infra и monitoring на c4m8, коэффициенты контейнеров равны 1.
+// Имена действий кластера имеют префикс kubernetes_v1_<minor>_cluster_
+func K8sClusterProduct(minor string) Product {
+	prefix := fmt.Sprintf("kubernetes_v1_%s_cluster_", minor)
+	controlPlaneFlavor := map[string]interface{}{"cpus": 4, "memory": 8, "name": "c4m8", "uuid": "flavor-c4m8"}
+
+	addNodes := func(component string) ActionFunc {
+		return func(order *Order, item *Item, attrs map[string]interface{}) error {
+			nodes, _ := item.Data.Config[component].([]interface{})
+			for _, n := range nodes {
+				node, _ := n.(map[string]interface{})
+				if node["name"] == attrs["name"] {
+					node["size"] = toFloat(node["size"]) + toFloat(attrs["new_nodes_count"])
+					return nil
+				}
+			}
+			return fmt.Errorf("%s '%v' not found", component, attrs["name"])
+		}
+	}
+	uncordonNodes := func(order *Order, item *Item, attrs map[string]interface{}) error {
+		return nil
+	}
+
+	return Product{
+		ItemType: "cluster",
+		Provider: "kubernetes_v1_" + minor,
+		Items: func(order *Order, attrs map[string]interface{}) error {
+			version, _ := attrs["version"].(map[string]interface{})
+			defaultNic, _ := attrs["default_nic"].(map[string]interface{})
+			worker, _ := attrs["worker"].(map[string]interface{})
+			ingress, _ := attrs["ingress"].(map[string]interface{})
+			workerFlavor, _ := worker["flavor"].(map[string]interface{})
+
+			order.AddItem("cluster", "kubernetes_v1_"+minor, map[string]interface{}{
+				"name":                   "fake-k8s-cluster",
+				"domain":                 attrs["domain"],
+				"version":                version["k8s_version"],
+				"product_version":        version["product_version"],
+				"platform":               attrs["platform"],
+				"data_center":            attrs["data_center"],
+				"net_segment":            defaultNic["net_segment"],
+				"control_panel_size":     attrs["control_panel_size"],
+				"container_cpu_ratio":    1,
+				"container_memory_ratio": 1,
+				"features":               attrs["features"],
+				"components":             map[string]interface{}{},
+				"gslb_only":              attrs["gslb_only"],
+				"products":               []interface{}{},
+				"control_plane": []interface{}{
+					map[string]interface{}{"role_name": "master", "size": 3, "flavor": controlPlaneFlavor},
+					map[string]interface{}{"role_name": "infra", "size": 2, "flavor": controlPlaneFlavor},
+					map[string]interface{}{"role_name": "monitoring", "size": 2, "flavor": controlPlaneFlavor},
+				},
+				"regions": []interface{}{
+					map[string]interface{}{
+						"name":                   "fake-region-01",
+						"size":                   worker["size"],
+						"flavor":                 workerFlavor,
+						"iscodes":                worker["iscodes"],
+						"pod_cpu_max":            toFloat(workerFlavor["cpus"]) - 1,
+						"pod_memory_max":         toFloat(workerFlavor["memory"]) - 1,
+						"container_cpu_ratio":    1,
+						"container_memory_ratio": 1,
+					},
+				},
+				"ingress_shards": []interface{}{
+					map[string]interface{}{
+						"name":     "fake-ingress-01",
+						"size":     ingress["size"],
+						"flavor":   ingress["flavor"],
+						"iscodes":  ingress["iscodes"],
+						"features": map[string]interface{}{"http2_protocol": true, "proxy_protocol": false},
+					},
+				},
+			})
+			return nil
+		},
+		Actions: map[string]ActionFunc{
+			prefix + "region_add_nodes":       addNodes("regions"),
+			prefix + "ingress_add_nodes":      addNodes("ingress_shards"),
+			prefix + "region_uncordon_nodes":  uncordonNodes,
+			prefix + "ingress_uncordon_nodes": uncordonNodes,
+		},
+		DeleteActions: []string{prefix + "delete"},
+	}
+}
+
+// editTopic применяет к топику параметры операции change_cleanup_policy.
+// Ограничение, не попавшее в _cleanup^limit_by, удаляется
+func editTopic(topic, params map[string]interface{}) {
+	fields := map[string]string{
+		"cleanup^policy":    "cleanup_policy",
+		"compression^type":  "compression_type",
+		"partitions_number": "partitions_number",
+		"segment^bytes":     "segment_bytes",
+		"retention^ms":      "retention_ms",
+		"retention^bytes":   "retention_bytes",
+	}
+	for param, field := range fields {
+		if value, ok := params[param]; ok {
+			topic[field] = portalValue(value)
+		}
+	}
+	if policy, ok := topic["cleanup_policy"].(string); ok && policy == "[compact,delete]" {
+		topic["cleanup_policy"] = "compact,delete"
+	}
+	switch params["_cleanup^limit_by"] {
+	case "time":
+		delete(topic, "retention_bytes")
+	case "size":
+		delete(topic, "retention_ms")
+	}
+}
+
+func findTopic(topics []interface{}, name string) map[string]interface{} {
+	for _, t := range topics {
+		topic, _ := t.(map[string]interface{})
+		if topic["topic_name"] == name {
+			return topic
+		}
+	}
+	return nil
+}
+
+// findTopicACL индекс ACL доступа к топикам того же клиента и роли или -1. ACL by_name
+// у клиента с ролью одна на все топики, ACL by_mask отдельная на каждую маску
+func findTopicACL(acls []interface{}, acl map[string]interface{}) int {
+	for i, a := range acls {
+		stored, _ := a.(map[string]interface{})
+		if stored["client_cn"] != acl["client_cn"] || stored["client_role"] != acl["client_role"] ||
+			stored["topic_type"] != acl["topic_type"] {
+			continue
+		}
+		if acl["topic_type"] == "by_name" || stored["topic_name"] == acl["topic_name"] {
+			return i
+		}
+	}
+	return -1
+}
+
+func findQuota(quotas []interface{}, clientCN string) int {
+	for i, q := range quotas {
+		quota, _ := q.(map[string]interface{})
+		if quota["client_cn"] == clientCN {
+			return i
+		}
+	}
+	return -1
+}
+
+// portalValue значение конфига в формате портала: числа хранятся строками
+func portalValue(value interface{}) interface{} {
+	if v, ok := value.(float64); ok {
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return value
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func vmACLs(attrs map[string]interface{}) []entities.AccessACL {
+	acls := []entities.AccessACL{}
+	grants, _ := attrs["ad_logon_grants"].([]interface{})
+	for _, g := range grants {
+		grant, _ := g.(map[string]interface{})
+		role, _ := grant["role"].(string)
+		acls = append(acls, entities.AccessACL{Role: role, Members: toStrings(grant["groups"])})
+	}
+	return acls
+}
+
+// vmActions обработчики действий с ВМ, общие для продуктов с ВМ
+func vmActions() map[string]ActionFunc {
+	return map[string]ActionFunc{
+		"expand_mount_point_new": func(order *Order, item *Item, attrs map[string]interface{}) error {
+			mounts, _ := item.Data.Config["extra_mounts"].([]interface{})
+			for _, m := range mounts {
+				mount, _ := m.(map[string]interface{})
+				if mount["mount"] == attrs["mount"] {
+					mount["size"] = toFloat(mount["size"]) + toFloat(attrs["size"])
+					return nil
+				}
+			}
+			return fmt.Errorf("mount point '%v' not found", attrs["mount"])
+		},
+		"vm_acls_add": func(order *Order, item *Item, attrs map[string]interface{}) error {
+			role, _ := attrs["role"].(map[string]interface{})
+			name, _ := role["group_name"].(string)
+			item.Data.ACLs = append(item.Data.ACLs, entities.AccessACL{Role: name, Members: toStrings(attrs["members"])})
+			return nil
+		},
+		"vm_acls_set_linux": func(order *Order, item *Item, attrs map[string]interface{}) error {
+			for i, acl := range item.Data.ACLs {
+				if acl.Role == attrs["role"] {
+					item.Data.ACLs[i].Members = toStrings(attrs["members"])
+					return nil
+				}
+			}
+			return fmt.Errorf("role '%v' not found", attrs["role"])
+		},
+		"vm_acls_remove": func(order *Order, item *Item, attrs map[string]interface{}) error {
+			acls := []entities.AccessACL{}
+			for _, acl := range item.Data.ACLs {
+				if acl.Role != attrs["role"] {
+					acls = append(acls, acl)
+				}
+			}
+			item.Data.ACLs = acls
+			return nil
+		},
+	}
+}
+
+func toStrings(value interface{}) []string {
+	result := []string{}
+	if values, ok := value.([]string); ok {
+		return append(result, values...)
+	}
+	values, _ := value.([]interface{})
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func toFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
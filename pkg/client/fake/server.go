@@ -0,0 +1,445 @@
+// Package fake локальный портал для тестов pkg/client и ресурсов провайдера без доступа к сети.
+//
+// Сервер эмулирует выдачу токена, справочники, проекты, сервисные аккаунты IAM и order-service:
+// заказы проходят через статусы pending -> success, действия меняют конфиги items,
+// а все запросы и вызванные действия записываются для проверок в тестах.
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/requests"
+)
+
+const (
+	ProjectName  = "proj-fake"
+	ClientID     = "fake-client-id"
+	ClientSecret = "fake-client-secret"
+	AccessToken  = "fake-access-token"
+
+	authPath = "/auth/realms/Portal/protocol/openid-connect/token"
+)
+
+// Request запрос, полученный сервером
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+}
+
+// Server локальный портал. Поля с данными можно менять до и во время теста
+type Server struct {
+	*httptest.Server
+
+	ClientID     string
+	ClientSecret string
+	AccessToken  string
+
+	Project           entities.Project
+	FinancialProjects []entities.FinancialProject
+
+	// References страницы справочников по имени директории
+	References map[string][]map[string]interface{}
+
+	// Products продукты по product_id, заказ неизвестного продукта завершается ошибкой
+	Products map[string]Product
+
+	// Actions обработчики действий по имени. Для остальных действий
+	// используется поведение по умолчанию, см. applyAction
+	Actions map[string]ActionFunc
+
+	// PendingPolls количество запросов заказа, в течение которых
+	// заказ и последнее действие остаются в статусе pending
+	PendingPolls int
+
+	mu            sync.Mutex
+	orders        map[string]*Order
+	ordered       []string
+	requests      []Request
+	calls         []ActionCall
+	handlers      map[string]http.HandlerFunc
+	failures      map[string]string
+	deleteActions map[string]bool
+
+	serviceAccounts    map[string]*ServiceAccount
+	serviceAccountKeys []string
+
+	restoreScheme, restoreAPI, restoreAuth string
+}
+
+// NewServer запускает сервер и направляет на него запросы pkg/client/requests.
+// Исходные адреса портала восстанавливаются в Close
+func NewServer() *Server {
+	s := &Server{
+		ClientID:     ClientID,
+		ClientSecret: ClientSecret,
+		AccessToken:  AccessToken,
+		Project:      defaultProject(),
+		FinancialProjects: []entities.FinancialProject{
+			{ID: "fake-fin-project-id", Name: "fake-fin-project", Code: "fake-fin-code", Type: "project"},
+		},
+		References:    map[string][]map[string]interface{}{},
+		Products:      map[string]Product{},
+		Actions:       vmActions(),
+		orders:        map[string]*Order{},
+		handlers:      map[string]http.HandlerFunc{},
+		failures:      map[string]string{},
+		deleteActions: map[string]bool{},
+
+		serviceAccounts: map[string]*ServiceAccount{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	s.restoreScheme = requests.PortalScheme
+	s.restoreAPI = requests.PortalAPI
+	s.restoreAuth = requests.PortalAuthURL
+	if err := requests.SetPortalURL(s.URL, s.URL); err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Start запускает сервер на время теста
+func Start(t testing.TB) *Server {
+	t.Helper()
+	s := NewServer()
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *Server) Close() {
+	s.Server.Close()
+	requests.PortalScheme = s.restoreScheme
+	requests.PortalAPI = s.restoreAPI
+	requests.PortalAuthURL = s.restoreAuth
+}
+
+// HandleFunc обработчик для эндпоинта, который сервер не эмулирует.
+// Имеет приоритет над встроенными обработчиками
+func (s *Server) HandleFunc(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method+" /"+strings.Trim(path, "/")] = handler
+}
+
+// AddReference добавляет страницу справочника и возвращает ее id
+func (s *Server) AddReference(directory, name string, tags []string, data map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tags == nil {
+		tags = []string{}
+	}
+	id := newID()
+	s.References[directory] = append(s.References[directory], map[string]interface{}{
+		"id":        id,
+		"name":      name,
+		"directory": directory,
+		"tags":      tags,
+		"data":      data,
+	})
+	return id
+}
+
+// AddProduct регистрирует продукт, который можно заказать
+func (s *Server) AddProduct(productID string, product Product) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Products[productID] = product
+	for name, action := range product.Actions {
+		s.Actions[name] = action
+	}
+	for _, name := range product.DeleteActions {
+		s.deleteActions[name] = true
+	}
+}
+
+// FailAction следующий вызов действия завершится статусом error с указанным выводом
+func (s *Server) FailAction(name, output string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[name] = output
+}
+
+// Requests все запросы к серверу, кроме запросов токена
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// ActionCalls действия заказов в порядке вызова
+func (s *Server) ActionCalls() []ActionCall {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]ActionCall(nil), s.calls...)
+}
+
+// ActionNames имена вызванных действий заказа в порядке вызова
+func (s *Server) ActionNames(orderID string) []string {
+	var names []string
+	for _, call := range s.ActionCalls() {
+		if call.OrderID == orderID {
+			names = append(names, call.Name)
+		}
+	}
+	return names
+}
+
+// Order копия заказа с текущим состоянием
+func (s *Server) Order(orderID string) (*Order, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	order, ok := s.orders[orderID]
+	if !ok {
+		return nil, false
+	}
+	return order.copy(), true
+}
+
+// Orders копии всех заказов в порядке создания
+func (s *Server) Orders() []*Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]*Order, 0, len(s.ordered))
+	for _, id := range s.ordered {
+		result = append(result, s.orders[id].copy())
+	}
+	return result
+}
+
+// ResetRequests очищает записанные запросы и действия
+func (s *Server) ResetRequests() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.calls = nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	path := "/" + strings.Trim(r.URL.Path, "/")
+
+	if r.Method == http.MethodPost && path == authPath {
+		s.serveToken(w, r, body)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  r.URL.Query(),
+		Body:   body,
+	})
+	authorized := r.Header.Get("Authorization") == "Bearer "+s.AccessToken
+	handler, custom := s.handlers[r.Method+" "+path]
+	s.mu.Unlock()
+
+	if !authorized {
+		writeError(w, http.StatusUnauthorized, "invalid access token")
+		return
+	}
+
+	// пользовательский обработчик вызывается без блокировки, чтобы он мог читать состояние сервера
+	if custom {
+		r.Body = io.NopCloser(strings.NewReader(string(body)))
+		handler(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case match(segments, "resource-manager", "api", "v2", "projects", "*"):
+		s.serveProject(w, r, segments[4])
+	case match(segments, "portal", "api", "v1", "projects", "*", "financial_projects"):
+		s.serveFinancialProjects(w, r)
+	case match(segments, "iam", "api", "v1", "projects", "*", "service_accounts"):
+		s.serveServiceAccounts(w, r, segments[4], body)
+	case match(segments, "iam", "api", "v1", "projects", "*", "service_accounts", "*"):
+		s.serveServiceAccount(w, r, segments[4], segments[6], body)
+	case match(segments, "references", "api", "v1", "pages"):
+		s.serveReferences(w, r)
+	case match(segments, "order-service", "api", "v1", "projects", "*", "orders"):
+		s.serveOrders(w, r, segments[4], body)
+	case match(segments, "order-service", "api", "v1", "projects", "*", "orders", "*"):
+		s.serveOrder(w, r, segments[6], body)
+	case match(segments, "order-service", "api", "v1", "projects", "*", "orders", "*", "order_fin_projects"):
+		s.serveOrderFinProjects(w, r, segments[6], body)
+	case match(segments, "order-service", "api", "v1", "projects", "*", "orders", "*", "actions", "*"):
+		s.serveOrderAction(w, r, segments[6], segments[8], body)
+	case match(segments, "order-service", "api", "v1", "projects", "*", "orders", "*", "actions", "history", "*", "output"):
+		s.serveActionOutput(w, r, segments[6], segments[9])
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("fake portal doesn't serve %s %s", r.Method, path))
+	}
+}
+
+func (s *Server) serveToken(w http.ResponseWriter, r *http.Request, body []byte) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if form.Get("client_id") != s.ClientID || form.Get("client_secret") != s.ClientSecret {
+		writeError(w, http.StatusUnauthorized, "invalid client credentials")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":       s.AccessToken,
+		"refresh_token":      "fake-refresh-token",
+		"expires_in":         3600,
+		"refresh_expires_in": 3600,
+		"token_type":         "Bearer",
+		"scope":              "openid",
+	})
+}
+
+func (s *Server) serveProject(w http.ResponseWriter, r *http.Request, name string) {
+	if r.Method != http.MethodGet || name != s.Project.Name {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project '%s' not found", name))
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": s.Project})
+}
+
+func (s *Server) serveFinancialProjects(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("query")
+	list := make([]entities.FinancialProject, 0)
+	for _, fp := range s.FinancialProjects {
+		if query == "" || strings.Contains(fp.Name, query) {
+			list = append(list, fp)
+		}
+	}
+	writeList(w, list, len(list))
+}
+
+func (s *Server) serveReferences(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	pages := make([]map[string]interface{}, 0)
+	for _, page := range s.References[query.Get("directory__name")] {
+		if matchReference(page, query) {
+			pages = append(pages, page)
+		}
+	}
+	writeJSON(w, http.StatusOK, pages)
+}
+
+// matchReference фильтры вида data__os__version=8 сравниваются с вложенными полями data,
+// tags__contains проверяет наличие у страницы всех тегов, перечисленных через запятую
+func matchReference(page map[string]interface{}, query url.Values) bool {
+	if filter := query.Get("tags__contains"); filter != "" {
+		tags, _ := page["tags"].([]string)
+		for _, tag := range strings.Split(filter, ",") {
+			if !contains(tags, tag) {
+				return false
+			}
+		}
+	}
+
+	for key := range query {
+		if !strings.HasPrefix(key, "data__") {
+			continue
+		}
+		var value interface{} = page
+		for _, field := range strings.Split(key, "__") {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			value = object[field]
+		}
+		if fmt.Sprint(value) != query.Get(key) {
+			return false
+		}
+	}
+	return true
+}
+
+func match(segments []string, pattern ...string) bool {
+	if len(segments) != len(pattern) {
+		return false
+	}
+	for i := range pattern {
+		if pattern[i] != "*" && pattern[i] != segments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	// без перевода строки в конце: часть клиентов обрезает "[]" вокруг тела ответа
+	content, err := json.Marshal(value)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(content)
+}
+
+func writeList(w http.ResponseWriter, list interface{}, total int) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"list": list,
+		"meta": map[string]interface{}{"total_count": total},
+	})
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{"error": message})
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func defaultProject() entities.Project {
+	return entities.Project{
+		Name:         ProjectName,
+		Title:        "Fake project",
+		Organization: "fake-organization",
+		Availability: true,
+		EnvironmentPrefix: entities.EnvironmentPrefix{
+			ID:   "fake-env-prefix-id",
+			Name: "fake-env-prefix",
+		},
+		InformationSystem: entities.InformationSystem{
+			ID:        "fake-information-system-id",
+			RisID:     "1",
+			Code:      "fake-ris",
+			ShortName: "fake",
+		},
+		ProjectEnvironment: entities.ProjectEnvironment{
+			ID:              "fake-environment-id",
+			Name:            "DEV",
+			EnvironmentType: "DEV",
+		},
+	}
+}
@@ -0,0 +1,311 @@
+package fake
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/iam"
+	"terraform-provider-vtb/pkg/client/orders"
+	"terraform-provider-vtb/pkg/client/references"
+	"terraform-provider-vtb/pkg/client/sources"
+)
+
+const computeProductID = "fake-compute-product-id"
+
+func startWithCompute(t *testing.T) (*Server, *auth.Credentials) {
+	s := Start(t)
+	s.AddProduct(computeProductID, ComputeProduct("astra"))
+
+	creds, err := auth.NewCredentials(ClientID, ClientSecret)
+	if err != nil {
+		t.Fatalf("Can't get access token from fake portal: %v", err)
+	}
+	return s, creds
+}
+
+func TestComputeOrderLifecycle(t *testing.T) {
+	s, creds := startWithCompute(t)
+
+	compute := orders.NewCompute(creds, ProjectName, computeProductID, orders.ComputeAttrs{
+		BasicAttrs: orders.BasicAttrs{
+			Domain: "corp.fake.ru",
+			Flavor: entities.Flavor{Cores: 2, Memory: 4, Name: "c2m4", UUID: "flavor-c2m4"},
+		},
+	})
+	err := compute.Create(orders.CreateOrderPayload{Label: "fake-vm", FinProjectID: "fake-fin-project-id"})
+	if err != nil {
+		t.Fatalf("Create compute order: %v", err)
+	}
+
+	item, err := compute.GetParentItem()
+	if err != nil {
+		t.Fatalf("Get parent item: %v", err)
+	}
+	config, ok := item.Data.Config.(entities.VMItemConfig)
+	if !ok || config.Hostname != "fake-vm-01" || config.Flavor.Name != "c2m4" {
+		t.Fatalf("Unexpected vm config: %#v", item.Data.Config)
+	}
+	if compute.FinancialSource.Name != "fake-fin-project" {
+		t.Fatalf("Unexpected financial source: %#v", compute.FinancialSource)
+	}
+
+	err = compute.ChangeFlavor(entities.Flavor{Cores: 4, Memory: 8, Name: "c4m8", UUID: "flavor-c4m8"})
+	if err != nil {
+		t.Fatalf("Change flavor: %v", err)
+	}
+	fetched, err := orders.GetComputeOrder(creds, ProjectName, compute.ID)
+	if err != nil {
+		t.Fatalf("Get compute order: %v", err)
+	}
+	item, _ = fetched.GetParentItem()
+	if flavor := item.Data.Config.(entities.VMItemConfig).Flavor; flavor.Name != "c4m8" {
+		t.Fatalf("Flavor wasn't changed: %#v", flavor)
+	}
+
+	s.PendingPolls = 1
+	err = compute.Delete(true)
+	if err != nil {
+		t.Fatalf("Delete compute order: %v", err)
+	}
+	for _, expected := range []string{"removing", "deprovisioned"} {
+		status, err := compute.GetOrderStatus()
+		if err != nil || status != expected {
+			t.Fatalf("Expected order status %s, got %s (%v)", expected, status, err)
+		}
+	}
+
+	expected := []string{"create", "resize_vm", "delete_vm"}
+	if actions := s.ActionNames(compute.ID); !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("Expected actions %v, got %v", expected, actions)
+	}
+	resize := s.ActionCalls()[1]
+	if resize.Attrs["flavor"].(map[string]interface{})["name"] != "c4m8" || resize.ItemID != item.ID {
+		t.Fatalf("Unexpected resize_vm call: %#v", resize)
+	}
+
+	projectOrders, err := orders.ListOrders(creds, ProjectName)
+	if err != nil {
+		t.Fatalf("List orders: %v", err)
+	}
+	if len(projectOrders) != 0 {
+		t.Fatalf("Deprovisioned orders must be skipped, got %d", len(projectOrders))
+	}
+}
+
+func TestFailedAction(t *testing.T) {
+	s, creds := startWithCompute(t)
+
+	compute := orders.NewCompute(creds, ProjectName, computeProductID, orders.ComputeAttrs{})
+	if err := compute.Create(orders.CreateOrderPayload{Label: "fake-vm"}); err != nil {
+		t.Fatalf("Create compute order: %v", err)
+	}
+
+	s.FailAction("stop_vm_soft", "vm is locked")
+	err := compute.StopSoft()
+	if err == nil {
+		t.Fatal("Expected stop_vm_soft to fail")
+	}
+	if state, _ := compute.GetState(); state != "on" {
+		t.Fatalf("Failed action must not change item state, got %s", state)
+	}
+}
+
+func TestProjectAndReferences(t *testing.T) {
+	s, creds := startWithCompute(t)
+	s.AddReference("images", "astra-1.7", []string{"general"}, map[string]interface{}{
+		"os": map[string]interface{}{"distribution": "astra", "version": "1.7"},
+	})
+
+	project, err := sources.GetProject(creds, ProjectName)
+	if err != nil {
+		t.Fatalf("Get project: %v", err)
+	}
+	if project.InformationSystem.Code != "fake-ris" {
+		t.Fatalf("Unexpected project: %#v", project)
+	}
+
+	finProject, err := sources.GetFinancialProjectByName(creds, ProjectName, "fake-fin-project")
+	if err != nil || finProject.ID != "fake-fin-project-id" {
+		t.Fatalf("Get financial project: %v, %#v", err, finProject)
+	}
+
+	version, err := references.GetImageOsVersion(*creds, "astra", "1.7")
+	if err != nil || version != "1.7" {
+		t.Fatalf("Get image os version: %v, %s", err, version)
+	}
+
+	if _, err := auth.NewCredentials(ClientID, "wrong-secret"); err == nil {
+		t.Fatal("Expected auth error for wrong client secret")
+	}
+}
+
+func TestK8sClusterDeleteAction(t *testing.T) {
+	s := Start(t)
+	s.AddProduct("fake-k8s-product-id", K8sClusterProduct("28"))
+	creds, err := auth.NewCredentials(ClientID, ClientSecret)
+	if err != nil {
+		t.Fatalf("Can't get access token from fake portal: %v", err)
+	}
+
+	cluster := orders.NewK8sClusterOrder(creds, ProjectName, "fake-k8s-product-id", orders.K8sClusterAttrs{
+		DataCenter: "5",
+		Version:    entities.VersionK8sCluster{K8sVersion: "1.28", ProductVersion: "1.28.3"},
+		Worker:     entities.WorkerIngressK8sCluster{Size: 3, Flavor: entities.K8sConfigNodeFlavor{Cpus: 8, Memory: 16}},
+	})
+	if err := cluster.Create(orders.CreateOrderPayload{Label: "fake-k8s"}); err != nil {
+		t.Fatalf("Create k8s cluster order: %v", err)
+	}
+
+	err = cluster.K8sClusterAddNodes("fake-region-01", 2, orders.CommonActionParams{DataCenter: "5", RegionAddNodesMax: 5}, "region")
+	if err != nil {
+		t.Fatalf("Add region nodes: %v", err)
+	}
+	item, _ := cluster.GetParentItem()
+	region := item.Data.Config.(entities.K8sClusterConfig).Regions[0]
+	if region.Size != 5 || region.PodCPUMax != 7 {
+		t.Fatalf("Unexpected region: %#v", region)
+	}
+
+	if err := cluster.Delete(); err != nil {
+		t.Fatalf("Delete k8s cluster order: %v", err)
+	}
+	if order, _ := s.Order(cluster.ID); order.Status != "deprovisioned" {
+		t.Fatalf("Expected deprovisioned order, got %s", order.Status)
+	}
+	expected := []string{"create", "kubernetes_v1_28_cluster_region_add_nodes", "kubernetes_v1_28_cluster_delete"}
+	if actions := s.ActionNames(cluster.ID); !reflect.DeepEqual(actions, expected) {
+		t.Fatalf("Expected actions %v, got %v", expected, actions)
+	}
+}
+
+func TestReferenceTagsContains(t *testing.T) {
+	s, creds := startWithCompute(t)
+	s.AddReference("gslb_servers", "dev.fake.zone", []string{"dev-srv-app", "available", "RELEASE"}, map[string]interface{}{
+		"name": "dev.fake.zone",
+	})
+	s.AddReference("gslb_servers", "draft.fake.zone", []string{"dev-srv-app", "RELEASE"}, map[string]interface{}{
+		"name": "draft.fake.zone",
+	})
+
+	zones, err := references.GetBalancerDnsZones(creds, "dev-srv-app")
+	if err != nil || !reflect.DeepEqual(zones, []string{"dev.fake.zone"}) {
+		t.Fatalf("Expected only zone with all tags, got %v (%v)", zones, err)
+	}
+}
+
+func TestServiceAccountLifecycle(t *testing.T) {
+	_, creds := startWithCompute(t)
+
+	account, err := iam.NewServiceAccount(creds, ProjectName, "ci-deployer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	account.Roles = []string{"roles/admin"}
+	created, err := account.Create()
+	if err != nil {
+		t.Fatalf("Create service account: %v", err)
+	}
+	if created.ApiKey.ClientId == "" || created.ApiKey.ClientSecret == "" {
+		t.Fatalf("Api key must be returned on create, got %+v", created.ApiKey)
+	}
+
+	read, err := iam.GetServiceAccountByName(creds, ProjectName, created.Name)
+	if err != nil {
+		t.Fatalf("Read service account: %v", err)
+	}
+	if read.ApiKey.ClientSecret != "" || len(read.Roles) != 1 || read.Roles[0].Name != "roles/admin" {
+		t.Fatalf("Unexpected service account: %+v", read)
+	}
+
+	if err := account.Delete(created.Name); err != nil {
+		t.Fatalf("Delete service account: %v", err)
+	}
+	if _, err := iam.GetServiceAccountByName(creds, ProjectName, created.Name); err == nil {
+		t.Fatal("Deleted service account is still readable")
+	}
+}
+
+func TestFindOrdersByKind(t *testing.T) {
+	s, creds := startWithCompute(t)
+
+	const clusterProductID = "fake-cluster-product-id"
+	s.AddProduct(clusterProductID, Product{
+		Items: func(order *Order, attrs map[string]interface{}) error {
+			order.AddItem("cluster", "kafka", map[string]interface{}{"cluster_name": "shared"})
+			order.AddItem("vm", "vsphere", VMConfig(1, "astra", attrs))
+			return nil
+		},
+	})
+
+	create := func(productID string) string {
+		order := orders.NewCompute(creds, ProjectName, productID, orders.ComputeAttrs{
+			BasicAttrs: orders.BasicAttrs{
+				Domain: "corp.fake.ru",
+				Flavor: entities.Flavor{Cores: 2, Memory: 4, Name: "c2m4", UUID: "flavor-c2m4"},
+			},
+		})
+		err := order.Create(orders.CreateOrderPayload{Label: "shared", FinProjectID: "fake-fin-project-id"})
+		if err != nil {
+			t.Fatalf("Create order of product '%s': %v", productID, err)
+		}
+		return order.ID
+	}
+	clusterIDs := []string{create(clusterProductID), create(clusterProductID)}
+	computeID := create(computeProductID)
+
+	vmKinds := []entities.ItemKind{{Type: "vm"}}
+	kafkaKinds := []entities.ItemKind{{Type: "cluster", Provider: "kafka"}}
+	expectOrders := func(search string, expected []string) func([]orders.Order, error) {
+		return func(found []orders.Order, err error) {
+			t.Helper()
+			if err != nil {
+				t.Fatalf("Search %s: %v", search, err)
+			}
+			var actual []string
+			for _, order := range found {
+				actual = append(actual, order.ID)
+			}
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("Search %s found %v, want %v", search, actual, expected)
+			}
+		}
+	}
+	allIDs := append(append([]string{}, clusterIDs...), computeID)
+
+	expectOrders("by label without kinds", allIDs)(
+		orders.FindOrdersByLabel(creds, ProjectName, "shared", nil),
+	)
+	expectOrders("by label of vm", []string{computeID})(
+		orders.FindOrdersByLabel(creds, ProjectName, "shared", vmKinds),
+	)
+	expectOrders("by label of kafka", clusterIDs)(
+		orders.FindOrdersByLabel(creds, ProjectName, "shared", kafkaKinds),
+	)
+
+	// ВМ с одинаковым hostname есть во всех заказах
+	expectOrders("by hostname without kinds", allIDs)(
+		orders.FindOrdersByHostname(creds, ProjectName, "fake-vm-01", nil),
+	)
+
+	s.ResetRequests()
+	expectOrders("by hostname of vm", []string{computeID})(
+		orders.FindOrdersByHostname(creds, ProjectName, "fake-vm-01", vmKinds),
+	)
+	// второй заказ кластера не запрашивается: продукт отсеян по первому заказу
+	fetched := 0
+	for _, request := range s.Requests() {
+		if request.Method == "GET" && strings.Contains(request.Path, "/orders/") {
+			fetched++
+		}
+	}
+	if fetched != 2 {
+		t.Errorf("Expected 2 order requests, got %d", fetched)
+	}
+
+	expectOrders("by cluster name of kafka", clusterIDs)(
+		orders.FindOrdersByClusterName(creds, ProjectName, "shared", kafkaKinds),
+	)
+}
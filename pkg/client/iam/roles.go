@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/entities"
 	"terraform-provider-vtb/pkg/client/requests"
 )
 
@@ -21,28 +24,28 @@ type UpdateRoleAttrs struct {
 	Permissions []string `json:"permissions"`
 }
 
+// RoleShortName короткое имя роли из полного имени organizations/<org>/roles/<name>
+func RoleShortName(name string) string {
+	return name[strings.LastIndex(name, "/")+1:]
+}
+
+// RoleFullName полное имя роли организации
+func RoleFullName(organization, name string) string {
+	return fmt.Sprintf("organizations/%s/roles/%s", organization, RoleShortName(name))
+}
+
 // Получение списка ролей
-func GetRoles(creds *auth.Credentials) (response interface{}, err error) {
+func GetRoles(creds *auth.Credentials) ([]entities.IAMRole, error) {
 	uri := "iam/api/v1/roles"
 	resp, err := requests.SendRequest(creds.AccessToken, uri, "GET", nil, nil)
 	if err != nil {
 		return nil, err
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, err
-	}
-	return response, nil
+	return readRoles(resp)
 }
 
 // Получение списка ролей сервиса
-func GetAvailableServiceRoles(creds *auth.Credentials, fullResourceName string) (response interface{}, err error) {
+func GetAvailableServiceRoles(creds *auth.Credentials, fullResourceName string) ([]entities.IAMRole, error) {
 	uri := "iam/api/v1/roles"
 	params := map[string]string{
 		"ful_resource_name": fullResourceName,
@@ -52,22 +55,11 @@ func GetAvailableServiceRoles(creds *auth.Credentials, fullResourceName string)
 	if err != nil {
 		return nil, err
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return readRoles(resp)
 }
 
 // Получение роли
-func GetRoleByName(creds *auth.Credentials, name string) (response interface{}, err error) {
+func GetRoleByName(creds *auth.Credentials, name string) (*entities.IAMRole, error) {
 	uri := fmt.Sprintf("iam/api/v1/roles/%s", name)
 	params := map[string]string{
 		"name": name,
@@ -77,22 +69,11 @@ func GetRoleByName(creds *auth.Credentials, name string) (response interface{},
 	if err != nil {
 		return nil, err
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return readRole(resp)
 }
 
 // Получение списка ролей организации
-func GetOrganizationRoles(creds *auth.Credentials, organization string) (response interface{}, err error) {
+func GetOrganizationRoles(creds *auth.Credentials, organization string) ([]entities.IAMRole, error) {
 	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles", organization)
 	params := map[string]string{
 		"parent_name": organization,
@@ -102,18 +83,7 @@ func GetOrganizationRoles(creds *auth.Credentials, organization string) (respons
 	if err != nil {
 		return nil, err
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return readRoles(resp)
 }
 
 // Создание роли
@@ -137,35 +107,24 @@ func CreateRole(creds *auth.Credentials, organization string, attrs CreateRoleAt
 	return nil
 }
 
-// Получение роли
-func GetOraganizationRoleByName(creds *auth.Credentials, organization, name string) (response interface{}, err error) {
-	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles/%s", organization, name)
+// Получение роли организации. name - короткое или полное имя роли
+func GetOrganizationRoleByName(creds *auth.Credentials, organization, name string) (*entities.IAMRole, error) {
+	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles/%s", organization, RoleShortName(name))
 	parameters := map[string]string{
 		"parent_name": organization,
-		"name":        name,
+		"name":        RoleShortName(name),
 	}
 
 	resp, err := requests.SendRequest(creds.AccessToken, uri, "GET", nil, parameters)
 	if err != nil {
 		return nil, err
 	}
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(body, &response)
-	if err != nil {
-		return nil, err
-	}
-
-	return response, nil
+	return readRole(resp)
 }
 
-// Обновление информации о роли
+// Обновление информации о роли. name - короткое или полное имя роли
 func UpdateRolePermissions(creds *auth.Credentials, organization, name string, attrs UpdateRoleAttrs) (err error) {
-	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles/%s", organization, name)
+	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles/%s", organization, RoleShortName(name))
 
 	data := map[string]interface{}{
 		"role": attrs,
@@ -184,12 +143,12 @@ func UpdateRolePermissions(creds *auth.Credentials, organization, name string, a
 	return nil
 }
 
-// Удаление роли
+// Удаление роли. name - короткое или полное имя роли
 func DeleteRole(creds *auth.Credentials, organization, name string) (err error) {
-	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles/%s", organization, name)
+	uri := fmt.Sprintf("iam/api/v1/organizations/%s/roles/%s", organization, RoleShortName(name))
 	params := map[string]string{
 		"parent_name": organization,
-		"name":        name,
+		"name":        RoleShortName(name),
 	}
 
 	_, err = requests.SendRequest(creds.AccessToken, uri, "DELETE", nil, params)
@@ -199,3 +158,39 @@ func DeleteRole(creds *auth.Credentials, organization, name string) (err error)
 
 	return nil
 }
+
+func readRole(resp *http.Response) (*entities.IAMRole, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data entities.IAMRole `json:"data"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Data, nil
+}
+
+func readRoles(resp *http.Response) ([]entities.IAMRole, error) {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data []entities.IAMRole `json:"data"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Data, nil
+}
@@ -8,6 +8,8 @@ import (
 )
 
 func TestGetRoles(t *testing.T) {
+	test.RequireCreds(t)
+
 	roles, err := GetRoles(test.SharedCreds)
 	if err != nil {
 		log.Fatal(err)
@@ -16,6 +18,8 @@ func TestGetRoles(t *testing.T) {
 }
 
 func TestGetAvailableServiceRoles(t *testing.T) {
+	test.RequireCreds(t)
+
 	avaliableRoles, err := GetAvailableServiceRoles(test.SharedCreds, "resource-manager/proj-e73127g7ry3p4t4")
 	if err != nil {
 		log.Fatal(err)
@@ -24,6 +28,8 @@ func TestGetAvailableServiceRoles(t *testing.T) {
 }
 
 func TestGetRoleByName(t *testing.T) {
+	test.RequireCreds(t)
+
 	role, err := GetRoleByName(test.SharedCreds, "organizations/vtb/roles/terraform-test")
 	if err != nil {
 		log.Fatal(err)
@@ -32,6 +38,8 @@ func TestGetRoleByName(t *testing.T) {
 }
 
 func TestGetOrganizationRoles(t *testing.T) {
+	test.RequireCreds(t)
+
 	oranizationRoles, err := GetOrganizationRoles(test.SharedCreds, "vtb")
 	if err != nil {
 		log.Fatal(err)
@@ -40,6 +48,8 @@ func TestGetOrganizationRoles(t *testing.T) {
 }
 
 func TestDeleteRole(t *testing.T) {
+	test.RequireCreds(t)
+
 	err := DeleteRole(test.SharedCreds, "vtb", "organizations/vtb/roles/terraform-test")
 	if err != nil {
 		log.Fatal(err)
@@ -48,6 +58,8 @@ func TestDeleteRole(t *testing.T) {
 }
 
 func TestCreateRole(t *testing.T) {
+	test.RequireCreds(t)
+
 	roleAttrs := &CreateRoleAttrs{
 		Name:        "terraform-test-2",
 		Title:       "terraform-test-2",
@@ -62,6 +74,8 @@ func TestCreateRole(t *testing.T) {
 }
 
 func TestUpdateRole(t *testing.T) {
+	test.RequireCreds(t)
+
 	roleAttrs := &UpdateRoleAttrs{
 		Title:       "terraform-test-2",
 		Description: "Обновленная тестовая роль",
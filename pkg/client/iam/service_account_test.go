@@ -8,19 +8,23 @@ import (
 )
 
 func TestCreateServiceAccount(t *testing.T) {
+	test.RequireCreds(t)
+
 	serviceAccount := ServiceAccount{
 		test.SharedCreds,
 		"proj-e73127g7ry3p4t4",
 		"buvaev_1",
 		[]string{"roles/admin"},
 	}
-	err := serviceAccount.Create()
+	_, err := serviceAccount.Create()
 	if err != nil {
 		log.Fatal(err)
 	}
 }
 
 func TestGetServiceAccounts(t *testing.T) {
+	test.RequireCreds(t)
+
 	serviceAccounts, err := GetServiceAccounts(test.SharedCreds, "proj-e73127g7ry3p4t4")
 	if err != nil {
 		log.Fatal(err)
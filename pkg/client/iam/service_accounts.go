@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
 	"terraform-provider-vtb/pkg/client/auth"
 	"terraform-provider-vtb/pkg/client/entities"
 	"terraform-provider-vtb/pkg/client/requests"
@@ -40,23 +41,23 @@ func NewServiceAccount(
 	return
 }
 
-// Создание сервисного аккаунта
-func (s *ServiceAccount) Create() (err error) {
+// Создание сервисного аккаунта. Ключ API (client_id и client_secret) возвращается
+// только в ответе на создание
+func (s *ServiceAccount) Create() (serviceAccount *entities.ServiceAccount, err error) {
 	uri := fmt.Sprintf("iam/api/v1/projects/%s/service_accounts", s.ProjectName)
 
 	data := s.preparePayloadData()
 
 	payload, err := json.Marshal(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = requests.SendRequest(s.creds.AccessToken, uri, "POST", payload, nil)
+	resp, err := requests.SendRequest(s.creds.AccessToken, uri, "POST", payload, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	return nil
+	return readServiceAccount(resp)
 }
 
 // Обновление сервисного аккаунта
@@ -119,6 +120,10 @@ func GetServiceAccountByName(creds *auth.Credentials, project_name, name string)
 	if err != nil {
 		return nil, err
 	}
+	return readServiceAccount(resp)
+}
+
+func readServiceAccount(resp *http.Response) (*entities.ServiceAccount, error) {
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
@@ -147,6 +152,7 @@ func GetServiceAccounts(creds *auth.Credentials, project_name string) ([]entitie
 	if err != nil {
 		return nil, err
 	}
+	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -118,7 +118,10 @@ func (o *AirflowCluster) GetAirflowVMItems() ([]entities.Item, error) {
 	}
 	var airflowItems []entities.Item
 	for _, item := range items {
-		vmConfig := item.Data.Config.(entities.VMItemConfig)
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
 		if slices.Contains(vmConfig.NodeRoles, "webserver") || slices.Contains(vmConfig.NodeRoles, "scheduler") || slices.Contains(vmConfig.NodeRoles, "worker") {
 			airflowItems = append(airflowItems, item)
 		}
@@ -136,7 +139,10 @@ func (o *AirflowCluster) GetAirflowItemsByType(itemRole string) ([]entities.Item
 	}
 	var airflowItems []entities.Item
 	for _, item := range items {
-		vmConfig := item.Data.Config.(entities.VMItemConfig)
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
 		if slices.Contains(vmConfig.NodeRoles, itemRole) {
 			airflowItems = append(airflowItems, item)
 		}
@@ -152,7 +158,11 @@ func (o *AirflowCluster) GetAirflowExtraMount(path string) (*entities.ExtraMount
 	if err != nil {
 		return nil, err
 	}
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	//	var em e.VMExtraMount
 	if len(extraMounts) == 0 {
 		return nil, nil
@@ -110,7 +110,10 @@ func (o *AirflowStandalone) GetAirflowVMItem() (*entities.Item, error) {
 		return nil, err
 	}
 	for _, item := range items {
-		vmConfig := item.Data.Config.(entities.VMItemConfig)
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
 		if slices.Contains(vmConfig.NodeRoles, "webserver") && slices.Contains(vmConfig.NodeRoles, "scheduler") {
 			return &item, nil
 		}
@@ -123,7 +126,11 @@ func (o *AirflowStandalone) GetAirflowExtraMount(path string) (*entities.ExtraMo
 	if err != nil {
 		return nil, err
 	}
-	extraMounts := item.Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	//	var em e.VMExtraMount
 	if len(extraMounts) == 0 {
 		return nil, nil
@@ -53,7 +53,10 @@ func (o *BalancerV3) formatActionName(actionName string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	bI := balancerItem.Data.Config.(entities.BalancerV3ItemData)
+	bI, err := entities.ItemConfig[entities.BalancerV3ItemData](balancerItem)
+	if err != nil {
+		return "", err
+	}
 
 	versions := strings.Split(bI.Build.SetupVersion, ".")
 	if len(versions) < 2 {
@@ -156,7 +159,11 @@ func (o *BalancerV3) GetExtraMount(path string) (*entities.ExtraMount, error) {
 		return nil, errors.New("length of elements less then 1")
 	}
 
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	if len(extraMounts) == 0 {
 		return nil, errors.New("length should be more then 0")
 	}
@@ -137,7 +137,11 @@ func (o *ClickHouse) GetClickhouseVmItems() ([]entities.Item, error) {
 
 	var ClickHouseVmItems []entities.Item
 	for _, vm := range vmItems {
-		nodeRoles := vm.Data.Config.(entities.VMItemConfig).NodeRoles
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vm)
+		if err != nil {
+			return nil, err
+		}
+		nodeRoles := vmConfig.NodeRoles
 		for _, role := range nodeRoles {
 			if role == "clickhouse" {
 				ClickHouseVmItems = append(ClickHouseVmItems, vm)
@@ -158,7 +162,10 @@ func (o *ClickHouse) GetUsers() ([]entities.DBOwners, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.ClickHouseItemConfig)
+	config, err := entities.ItemConfig[entities.ClickHouseItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 
 	return config.DBOwners, nil
 }
@@ -275,7 +282,11 @@ func (o *ClickHouse) GetExtraMounts() (extraMounts []entities.ExtraMount, err er
 		return nil, err
 	}
 
-	vmExtraMount := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	vmExtraMount := vmConfig.ExtraMounts
 
 	for _, extraMount := range vmExtraMount {
 		formatedExtraMount, err := o.GetExtraMount(extraMount.Mount)
@@ -296,7 +307,11 @@ func (o *ClickHouse) GetExtraMount(path string) (*entities.ExtraMount, error) {
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, errors.New("list of extra mounts is empty")
 		}
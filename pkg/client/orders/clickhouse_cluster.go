@@ -140,7 +140,11 @@ func (o *ClickHouseCluster) GetClickHouseClusterVmItems() ([]entities.Item, erro
 
 	var ClickHouseClusterVmItems []entities.Item
 	for _, vm := range vmItems {
-		nodeRoles := vm.Data.Config.(entities.VMItemConfig).NodeRoles
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vm)
+		if err != nil {
+			return nil, err
+		}
+		nodeRoles := vmConfig.NodeRoles
 		for _, role := range nodeRoles {
 			if role == "clickhouse" {
 				ClickHouseClusterVmItems = append(ClickHouseClusterVmItems, vm)
@@ -177,7 +181,11 @@ func (o *ClickHouseCluster) GetClickhouseClusterZookeeperVmItems() ([]entities.I
 
 	var ClickHouseClusterZookeeperVmItems []entities.Item
 	for _, vm := range vmItems {
-		nodeRoles := vm.Data.Config.(entities.VMItemConfig).NodeRoles
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vm)
+		if err != nil {
+			return nil, err
+		}
+		nodeRoles := vmConfig.NodeRoles
 		for _, role := range nodeRoles {
 			if role == "zookeeper" {
 				ClickHouseClusterZookeeperVmItems = append(ClickHouseClusterZookeeperVmItems, vm)
@@ -198,7 +206,10 @@ func (o *ClickHouseCluster) GetUsers() ([]entities.DBOwners, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.ClickhouseClusterItemConfig)
+	config, err := entities.ItemConfig[entities.ClickhouseClusterItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 
 	return config.DBOwners, nil
 }
@@ -324,7 +335,11 @@ func (o *ClickHouseCluster) GetExtraMounts() (extraMounts []entities.ExtraMount,
 		return nil, err
 	}
 
-	vmExtraMount := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	vmExtraMount := vmConfig.ExtraMounts
 
 	for _, extraMount := range vmExtraMount {
 		formatedExtraMount, err := o.GetExtraMount(extraMount.Mount)
@@ -345,7 +360,11 @@ func (o *ClickHouseCluster) GetExtraMount(path string) (*entities.ExtraMount, er
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, errors.New("list of extra mounts is empty")
 		}
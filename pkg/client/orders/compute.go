@@ -208,7 +208,11 @@ func (o *Compute) GetExtraMount(path string) (*entities.ExtraMount, error) {
 		return nil, err
 	}
 
-	extraMounts := item.Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	if len(extraMounts) == 0 {
 		return nil, nil
 	}
@@ -225,8 +229,25 @@ func (o *Compute) GetExtraMount(path string) (*entities.ExtraMount, error) {
 }
 
 func (o *Compute) Start() error {
+	return o.vmPowerAction("start_vm", "off")
+}
+
+func (o *Compute) StopSoft() error {
+	return o.vmPowerAction("stop_vm_soft", "on")
+}
+
+func (o *Compute) StopHard() error {
+	return o.vmPowerAction("stop_vm_hard", "on")
+}
+
+func (o *Compute) Reboot() error {
+	return o.vmPowerAction("reset_vm", "on")
+}
 
-	if err := o.requiredState("off"); err != nil {
+// vmPowerAction запускает действие управления питанием ВМ, доступное в состоянии requiredState
+func (o *Compute) vmPowerAction(action, requiredState string) error {
+
+	if err := o.requiredState(requiredState); err != nil {
 		return err
 	}
 
@@ -249,7 +270,7 @@ func (o *Compute) Start() error {
 		return err
 	}
 
-	uri := o.generateOrderdActionUri("start_vm")
+	uri := o.generateOrderdActionUri(action)
 	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
 	if err != nil {
 		return err
@@ -262,32 +283,30 @@ func (o *Compute) Start() error {
 	return err
 }
 
-func (o *Compute) StopSoft() error {
-
-	if err := o.requiredState("on"); err != nil {
-		return err
-	}
+func (o *Compute) ChangeAccessGroup(changeAD entities.ADLogonGrants) error {
 
-	itemID, err := o.GetParentItemID()
+	vmItems, err := o.GetVMItems()
 	if err != nil {
 		return err
 	}
 
-	attrs := map[string]interface{}{}
+	attrs := map[string]interface{}{
+		"members": changeAD.Groups,
+		"role":    changeAD.Role,
+	}
 
 	data := map[string]interface{}{
-		"item_id": itemID,
+		"item_id": vmItems[0].ID,
 		"order": map[string]interface{}{
 			"attrs": o.addCreatedWithOpenTofuTagToAttrs(attrs),
 		},
 	}
-
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
 
-	uri := o.generateOrderdActionUri("stop_vm_soft")
+	uri := o.generateOrderdActionUri("vm_acls_set_linux")
 	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
 	if err != nil {
 		return err
@@ -300,7 +319,7 @@ func (o *Compute) StopSoft() error {
 	return err
 }
 
-func (o *Compute) ChangeAccessGroup(changeAD entities.ADLogonGrants) error {
+func (o *Compute) AddAccessGroup(members entities.ADLogonGrants, permissions []string) error {
 
 	vmItems, err := o.GetVMItems()
 	if err != nil {
@@ -308,8 +327,11 @@ func (o *Compute) ChangeAccessGroup(changeAD entities.ADLogonGrants) error {
 	}
 
 	attrs := map[string]interface{}{
-		"members": changeAD.Groups,
-		"role":    changeAD.Role,
+		"members": members.Groups,
+		"role": map[string]interface{}{
+			"group_name":  members.Role,
+			"permissions": permissions,
+		},
 	}
 
 	data := map[string]interface{}{
@@ -323,7 +345,7 @@ func (o *Compute) ChangeAccessGroup(changeAD entities.ADLogonGrants) error {
 		return err
 	}
 
-	uri := o.generateOrderdActionUri("vm_acls_set_linux")
+	uri := o.generateOrderdActionUri("vm_acls_add")
 	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
 	if err != nil {
 		return err
@@ -336,7 +358,7 @@ func (o *Compute) ChangeAccessGroup(changeAD entities.ADLogonGrants) error {
 	return err
 }
 
-func (o *Compute) AddAccessGroup(members entities.ADLogonGrants, permissions []string) error {
+func (o *Compute) DeleteAccessGroup(changeAD entities.ADLogonGrants) error {
 
 	vmItems, err := o.GetVMItems()
 	if err != nil {
@@ -344,11 +366,8 @@ func (o *Compute) AddAccessGroup(members entities.ADLogonGrants, permissions []s
 	}
 
 	attrs := map[string]interface{}{
-		"members": members.Groups,
-		"role": map[string]interface{}{
-			"group_name":  members.Role,
-			"permissions": permissions,
-		},
+		"members": changeAD.Groups,
+		"role":    changeAD.Role,
 	}
 
 	data := map[string]interface{}{
@@ -362,7 +381,7 @@ func (o *Compute) AddAccessGroup(members entities.ADLogonGrants, permissions []s
 		return err
 	}
 
-	uri := o.generateOrderdActionUri("vm_acls_add")
+	uri := o.generateOrderdActionUri("vm_acls_remove")
 	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
 	if err != nil {
 		return err
@@ -375,30 +394,88 @@ func (o *Compute) AddAccessGroup(members entities.ADLogonGrants, permissions []s
 	return err
 }
 
-func (o *Compute) DeleteAccessGroup(changeAD entities.ADLogonGrants) error {
+// GetSnapshots снимки ВМ заказа, кроме удаленных
+func (o *Compute) GetSnapshots() ([]entities.Item, error) {
+	if _, err := o.itemCreated(); err != nil {
+		return nil, err
+	}
 
-	vmItems, err := o.GetVMItems()
+	snapshots := []entities.Item{}
+	for _, item := range o.Data {
+		if item.Type == "snapshot" && item.Data.State != "deleted" {
+			snapshots = append(snapshots, item)
+		}
+	}
+	return snapshots, nil
+}
+
+// GetSnapshot снимок ВМ по имени. Если снимка нет, возвращает nil
+func (o *Compute) GetSnapshot(name string) (*entities.Item, error) {
+	snapshots, err := o.GetSnapshots()
+	if err != nil {
+		return nil, err
+	}
+	for i := range snapshots {
+		config, err := entities.ItemConfig[entities.SnapshotItemConfig](&snapshots[i])
+		if err != nil {
+			return nil, err
+		}
+		if config.Name == name {
+			return &snapshots[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateSnapshot создает снимок дисков volumes ВМ, пустой volumes - снимок всех дисков.
+// Пустой deleteDate - снимок без срока удаления
+func (o *Compute) CreateSnapshot(name, deleteDate string, volumes []string) error {
+	itemID, err := o.GetParentItemID()
 	if err != nil {
 		return err
 	}
 
 	attrs := map[string]interface{}{
-		"members": changeAD.Groups,
-		"role":    changeAD.Role,
+		"name": name,
+	}
+	if deleteDate != "" {
+		attrs["delete_date"] = deleteDate
+	}
+	if len(volumes) > 0 {
+		attrs["volumes"] = volumes
 	}
+	return o.snapshotAction("create_snapshot", itemID, attrs)
+}
+
+// DeleteSnapshot удаляет снимок с идентификатором item снимка snapshotItemID
+func (o *Compute) DeleteSnapshot(snapshotItemID string) error {
+	return o.snapshotAction("delete_snapshot", snapshotItemID, map[string]interface{}{})
+}
+
+// RevertSnapshot возвращает диски ВМ к состоянию снимка snapshotItemID
+func (o *Compute) RevertSnapshot(snapshotItemID string) error {
+	return o.snapshotAction("revert_snapshot", snapshotItemID, map[string]interface{}{})
+}
+
+// snapshotAction вызывает действие со снимком ВМ.
+// Имена действий create_snapshot, delete_snapshot и revert_snapshot не сверены с графом
+// продукта ВМ на портале, поэтому ресурс vtb_compute_snapshot не зарегистрирован в провайдере.
+// Перед регистрацией имена и attrs действий нужно сверить с графом
+func (o *Compute) snapshotAction(action, itemID string, attrs map[string]interface{}) error {
 
 	data := map[string]interface{}{
-		"item_id": vmItems[0].ID,
+		"item_id": itemID,
 		"order": map[string]interface{}{
 			"attrs": o.addCreatedWithOpenTofuTagToAttrs(attrs),
 		},
 	}
+
 	payload, err := json.Marshal(data)
 	if err != nil {
 		return err
 	}
 
-	uri := o.generateOrderdActionUri("vm_acls_remove")
+	uri := o.generateOrderdActionUri(action)
 	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
 	if err != nil {
 		return err
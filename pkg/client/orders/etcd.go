@@ -117,7 +117,10 @@ func (o *EtcdOrder) GetUsers() ([]entities.EtcdUser, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.EtcdlItemConfig)
+	config, err := entities.ItemConfig[entities.EtcdlItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 	return config.EtcdUser, nil
 }
 
@@ -193,7 +196,11 @@ func (o *EtcdOrder) GetExtraMounts() (extraMounts []entities.ExtraMount, err err
 		return nil, err
 	}
 
-	vmExtraMount := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	vmExtraMount := vmConfig.ExtraMounts
 
 	for _, extraMount := range vmExtraMount {
 		formatedExtraMount, err := o.GetExtraMount(extraMount.Mount)
@@ -214,7 +221,11 @@ func (o *EtcdOrder) GetExtraMount(path string) (*entities.ExtraMount, error) {
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, errors.New("list of extra mounts is empty")
 		}
@@ -111,7 +111,10 @@ func (o *GrafanaOrder) GetUsers() ([]entities.GrafanaUser, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.GrafanaItemConfig)
+	config, err := entities.ItemConfig[entities.GrafanaItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 	return config.GrafanaUsers, nil
 }
 
@@ -188,7 +191,11 @@ func (o *GrafanaOrder) GetExtraMount(path string) (*entities.ExtraMount, error)
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, errors.New("list of extra mounts is empty")
 		}
@@ -245,7 +252,11 @@ func (o *GrafanaOrder) GetExtraMounts() (extraMounts []entities.ExtraMount, err
 		return nil, err
 	}
 
-	vmExtraMount := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	vmExtraMount := vmConfig.ExtraMounts
 
 	for _, extraMount := range vmExtraMount {
 		formatedExtraMount, err := o.GetExtraMount(extraMount.Mount)
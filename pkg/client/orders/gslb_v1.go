@@ -514,7 +514,11 @@ func (o *GSLBV1) GetExtraMount(path string) (*entities.ExtraMount, error) {
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, fmt.Errorf("list extra mounts is empty")
 		}
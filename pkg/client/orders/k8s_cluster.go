@@ -691,7 +691,10 @@ func (o *K8sClusterOrder) formatActionName(actionName string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	item := clusterItem.Data.Config.(entities.K8sClusterConfig)
+	item, err := entities.ItemConfig[entities.K8sClusterConfig](clusterItem)
+	if err != nil {
+		return "", err
+	}
 
 	versions := strings.Split(item.ProductVersion, ".")
 	if len(versions) < 2 {
@@ -430,7 +430,10 @@ func (o *K8sContainerSpaceOrder) formatActionName(actionName string) (string, er
 	if err != nil {
 		return "", err
 	}
-	item := spaceItem.Data.Config.(entities.K8sContainerSpaceConfig)
+	item, err := entities.ItemConfig[entities.K8sContainerSpaceConfig](spaceItem)
+	if err != nil {
+		return "", err
+	}
 
 	versions := strings.Split(item.ControlPlane.ProductVersion, ".")
 	if len(versions) < 2 {
@@ -156,51 +156,59 @@ func (o *Kafka) GetState() (string, error) {
 }
 
 func (o *Kafka) GetTopics() ([]entities.KafkaTopic, error) {
-	item, err := o.GetClusterItem()
+	config, err := o.getClusterConfig()
 	if err != nil {
 		return nil, err
 	}
-	return item.Data.Config.(entities.KafkaItemConfig).Topics, nil
+	return config.Topics, nil
 }
 
 func (o *Kafka) GetACLs() ([]entities.KafkaACL, error) {
-	item, err := o.GetClusterItem()
+	config, err := o.getClusterConfig()
 	if err != nil {
 		return nil, err
 	}
-	return item.Data.Config.(entities.KafkaItemConfig).ACLs, nil
+	return config.ACLs, nil
 }
 
 func (o *Kafka) GetTransactionalACLs() ([]entities.KafkaTransactionalACL, error) {
-	item, err := o.GetClusterItem()
+	config, err := o.getClusterConfig()
 	if err != nil {
 		return nil, err
 	}
-	return item.Data.Config.(entities.KafkaItemConfig).TransactionalACLs, nil
+	return config.TransactionalACLs, nil
 }
 
 func (o *Kafka) GetIdempotentACLs() ([]entities.KafkaIdempotentACL, error) {
-	item, err := o.GetClusterItem()
+	config, err := o.getClusterConfig()
 	if err != nil {
 		return nil, err
 	}
-	return item.Data.Config.(entities.KafkaItemConfig).IdempotentACLs, nil
+	return config.IdempotentACLs, nil
 }
 
 func (o *Kafka) GetGroupACLs() ([]entities.KafkaGroupACL, error) {
-	item, err := o.GetClusterItem()
+	config, err := o.getClusterConfig()
 	if err != nil {
 		return nil, err
 	}
-	return item.Data.Config.(entities.KafkaItemConfig).GroupACLs, nil
+	return config.GroupACLs, nil
 }
 
 func (o *Kafka) GetQuotas() ([]entities.KafkaQuota, error) {
-	item, err := o.GetClusterItem()
+	config, err := o.getClusterConfig()
 	if err != nil {
 		return nil, err
 	}
-	return item.Data.Config.(entities.KafkaItemConfig).Quotas, nil
+	return config.Quotas, nil
+}
+
+func (o *Kafka) getClusterConfig() (entities.KafkaItemConfig, error) {
+	item, err := o.GetClusterItem()
+	if err != nil {
+		return entities.KafkaItemConfig{}, err
+	}
+	return entities.ItemConfig[entities.KafkaItemConfig](item)
 }
 
 func (o *Kafka) GetClusterItem() (*entities.Item, error) {
@@ -225,7 +233,11 @@ func (o *Kafka) GetKafkaVMItems() ([]entities.Item, error) {
 	}
 	var kafkaItems []entities.Item
 	for _, item := range items {
-		for _, role := range item.Data.Config.(entities.VMItemConfig).NodeRoles {
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range vmConfig.NodeRoles {
 			if role == "kafka" {
 				kafkaItems = append(kafkaItems, item)
 				break
@@ -245,7 +257,11 @@ func (o *Kafka) GetZookeeperVMItems() ([]entities.Item, error) {
 	}
 	var zookeeperItems []entities.Item
 	for _, item := range items {
-		for _, role := range item.Data.Config.(entities.VMItemConfig).NodeRoles {
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range vmConfig.NodeRoles {
 			if role == "zookeeper" {
 				zookeeperItems = append(zookeeperItems, item)
 				break
@@ -1199,7 +1215,11 @@ func (o *Kafka) GetKafkaExtraMount(path string) (*entities.ExtraMount, error) {
 	if len(items) == 0 {
 		return nil, errors.New("get kafka vm items have zero length")
 	}
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	//	var em e.VMExtraMount
 	if len(extraMounts) == 0 {
 		return nil, nil
@@ -1224,7 +1244,11 @@ func (o *Kafka) GetZookeeperExtraMount(path string) (*entities.ExtraMount, error
 	if len(items) == 0 {
 		return nil, errors.New("get zookeeper vm items have zero length")
 	}
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	//	var em e.VMExtraMount
 	if len(extraMounts) == 0 {
 		return nil, nil
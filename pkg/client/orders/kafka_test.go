@@ -13,6 +13,7 @@ const kafkaClusterOrderID = "76747987-fe7b-4e3e-9fee-dfc621afcf1c"
 // test should running with increased timeout
 // go test -run TestKafkaQuotasOrder -timeout 9999s
 func TestKafkaQuotasOrder(t *testing.T) {
+	test.UseCassette(t)
 
 	order, err := GetKafkaOrder(test.SharedCreds, "proj-h1eu89sx40", kafkaClusterOrderID)
 	if err != nil {
@@ -38,6 +39,8 @@ func TestKafkaQuotasOrder(t *testing.T) {
 }
 
 func TestKafkaChangeFlavor(t *testing.T) {
+	test.UseCassette(t)
+
 	order, err1 := GetKafkaOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", kafkaClusterOrderID)
 	if err1 != nil {
 		log.Fatalln(err1)
@@ -306,7 +306,11 @@ func (o *Nginx) GetExtraMount(path string) (*entities.ExtraMount, error) {
 		return nil, errors.New("VMs len count must be equal 1")
 	}
 
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	if len(extraMounts) == 0 {
 		return nil, errors.New("length should be more then 0")
 	}
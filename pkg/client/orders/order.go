@@ -36,6 +36,9 @@ type Order struct {
 	UpdatedAt       string                   `json:"updated_at"`
 	Data            []entities.Item          `json:"data"`
 	FinancialSource entities.FinancialSource `json:"financial_source,omitempty"`
+
+	// Срок жизни заказа в секундах, 0 если не ограничен. Заполняется в ListOrders
+	Lifetime int64 `json:"-"`
 }
 
 type LastAction struct {
@@ -115,6 +118,53 @@ func (o *Order) DeleteTwoLayer(async bool) error {
 	return err
 }
 
+// StopTwoLayer вызов базового действия "Выключить" для двухуровневых продуктов
+func (o *Order) StopTwoLayer(async bool) error {
+	return o.twoLayerPowerAction("stop_two_layer", "on", async)
+}
+
+// StartTwoLayer вызов базового действия "Включить" для двухуровневых продуктов
+func (o *Order) StartTwoLayer(async bool) error {
+	return o.twoLayerPowerAction("start_two_layer", "off", async)
+}
+
+func (o *Order) twoLayerPowerAction(action, requiredState string, async bool) error {
+
+	if err := o.requiredState(requiredState); err != nil {
+		return err
+	}
+
+	itemID, err := o.GetParentItemID()
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"item_id": itemID,
+		"order": map[string]interface{}{
+			"attrs": o.addCreatedWithOpenTofuTagToAttrs(map[string]interface{}{}),
+		},
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	uri := o.generateOrderdActionUri(action)
+	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
+	if err != nil {
+		return err
+	}
+
+	if !async {
+		err = o.WaitSuccess(10)
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
 func (o *Order) GetLastActionOutputData() (any, error) {
 
 	params := map[string]string{
@@ -0,0 +1,272 @@
+package orders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/entities"
+	"terraform-provider-vtb/pkg/client/requests"
+)
+
+const ordersPerPage = 100
+
+// ListOrders возвращает все заказы проекта, кроме удаленных
+func ListOrders(creds *auth.Credentials, projectName string) ([]Order, error) {
+
+	var result []Order
+	for page := 1; ; page++ {
+		params := map[string]string{
+			"include":  "total_count",
+			"page":     strconv.Itoa(page),
+			"per_page": strconv.Itoa(ordersPerPage),
+		}
+		uri := fmt.Sprintf("order-service/api/v1/projects/%s/orders", projectName)
+		resp, err := requests.SendRequest(creds.AccessToken, uri, "GET", nil, params)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		// items в списке не разбираются: заказы с неизвестными типами items не должны ломать поиск
+		var response struct {
+			List []struct {
+				ID          string `json:"id"`
+				Label       string `json:"label"`
+				Status      string `json:"status"`
+				ProductID   string `json:"product_id"`
+				ProjectName string `json:"project_name"`
+				CreatedAt   string `json:"created_at"`
+				UpdatedAt   string `json:"updated_at"`
+
+				FinancialSource entities.FinancialSource `json:"financial_source"`
+				Lifetime        json.RawMessage          `json:"lifetime"`
+			} `json:"list"`
+			Meta struct {
+				TotalCount int `json:"total_count"`
+			} `json:"meta"`
+		}
+		err = json.Unmarshal(body, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, order := range response.List {
+			if order.Status == "deprovisioned" {
+				continue
+			}
+			result = append(result, Order{
+				Creds:       creds,
+				ID:          order.ID,
+				Label:       order.Label,
+				Status:      order.Status,
+				ProductID:   order.ProductID,
+				ProjectName: projectName,
+				CreatedAt:   order.CreatedAt,
+				UpdatedAt:   order.UpdatedAt,
+
+				FinancialSource: order.FinancialSource,
+				Lifetime:        parseLifetime(order.Lifetime),
+			})
+		}
+
+		fetched := (page-1)*ordersPerPage + len(response.List)
+		if len(response.List) < ordersPerPage || fetched >= response.Meta.TotalCount {
+			break
+		}
+	}
+	return result, nil
+}
+
+// parseLifetime срок жизни заказа в секундах.
+// Портал принимает lifetime строкой, поэтому разбираются и строка, и число
+func parseLifetime(raw json.RawMessage) int64 {
+	value := strings.Trim(string(raw), `"`)
+	if value == "" || value == "null" {
+		return 0
+	}
+	lifetime, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(lifetime)
+}
+
+// FindOrdersByLabel возвращает заказы проекта с указанной меткой.
+// Непустой kinds оставляет только заказы, основной item которых подходит под один из kinds
+func FindOrdersByLabel(
+	creds *auth.Credentials,
+	projectName, label string,
+	kinds []entities.ItemKind,
+) ([]Order, error) {
+
+	orders, err := ListOrders(creds, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var found []Order
+	for _, order := range orders {
+		if order.Label != label {
+			continue
+		}
+		if len(kinds) > 0 {
+			items, err := GetOrderItems(creds, projectName, order.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !hasParentItemOfKind(items, kinds) {
+				continue
+			}
+		}
+		found = append(found, order)
+	}
+	return found, nil
+}
+
+// FindOrdersByHostname возвращает заказы проекта, у которых есть ВМ с указанным hostname.
+// Непустой kinds оставляет только заказы, основной item которых подходит под один из kinds
+func FindOrdersByHostname(
+	creds *auth.Credentials,
+	projectName, hostname string,
+	kinds []entities.ItemKind,
+) ([]Order, error) {
+	return findOrdersByItem(creds, projectName, kinds, func(item entities.Item) bool {
+		config, ok := item.Data.Config.(entities.VMItemConfig)
+		return ok && config.Hostname == hostname
+	})
+}
+
+// FindOrdersByClusterName возвращает заказы проекта, у которых есть кластер с указанным именем.
+// Непустой kinds оставляет только заказы, основной item которых подходит под один из kinds
+func FindOrdersByClusterName(
+	creds *auth.Credentials,
+	projectName, clusterName string,
+	kinds []entities.ItemKind,
+) ([]Order, error) {
+	return findOrdersByItem(creds, projectName, kinds, func(item entities.Item) bool {
+		return itemClusterName(item) == clusterName
+	})
+}
+
+// findOrdersByItem запрашивает items заказов по одному, поэтому заказы отбираются по продукту:
+// у заказов одного продукта один и тот же основной item, и если он не подходит под kinds
+// у первого заказа продукта с items, остальные заказы продукта не запрашиваются
+func findOrdersByItem(
+	creds *auth.Credentials,
+	projectName string,
+	kinds []entities.ItemKind,
+	match func(item entities.Item) bool,
+) ([]Order, error) {
+
+	orders, err := ListOrders(creds, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	skippedProducts := map[string]bool{}
+	var found []Order
+	for _, order := range orders {
+		if skippedProducts[order.ProductID] {
+			continue
+		}
+		items, err := GetOrderItems(creds, projectName, order.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			continue
+		}
+		if !hasParentItemOfKind(items, kinds) {
+			if order.ProductID != "" {
+				skippedProducts[order.ProductID] = true
+			}
+			continue
+		}
+		for _, item := range items {
+			if match(item) {
+				found = append(found, order)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+// hasParentItemOfKind сообщает, подходит ли item без родителя под один из kinds.
+// Пустой kinds подходит для любого заказа
+func hasParentItemOfKind(items []entities.Item, kinds []entities.ItemKind) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+	for _, item := range items {
+		if item.Data.Parent != "" {
+			continue
+		}
+		for _, kind := range kinds {
+			if kind.Matches(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetOrderItems возвращает items заказа, пропуская items, которые провайдер не умеет разбирать
+func GetOrderItems(creds *auth.Credentials, projectName, orderID string) ([]entities.Item, error) {
+
+	uri := fmt.Sprintf("order-service/api/v1/projects/%s/orders/%s", projectName, orderID)
+	resp, err := requests.SendRequest(creds.AccessToken, uri, "GET", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Data []json.RawMessage `json:"data"`
+	}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []entities.Item
+	for _, raw := range response.Data {
+		var item entities.Item
+		if err := json.Unmarshal(raw, &item); err != nil {
+			continue
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// itemClusterName достает поле ClusterName из конфига item, если оно есть
+func itemClusterName(item entities.Item) string {
+	config := reflect.ValueOf(item.Data.Config)
+	if config.Kind() == reflect.Ptr {
+		config = config.Elem()
+	}
+	if config.Kind() != reflect.Struct {
+		return ""
+	}
+	field := config.FieldByName("ClusterName")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
@@ -156,7 +156,11 @@ func (o *PostgresqlOrder) GetDB(dbName string) (*entities.Item, error) {
 	}
 
 	for _, item := range dbItems {
-		if item.Data.Config.(entities.PostgresqlDbItemConfig).DbName == dbName {
+		config, err := entities.ItemConfig[entities.PostgresqlDbItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
+		if config.DbName == dbName {
 			return &item, nil
 		}
 	}
@@ -170,7 +174,10 @@ func (o *PostgresqlOrder) GetUsers() ([]entities.PostgresqlItemDbUsers, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.PostgresqlItemConfig)
+	config, err := entities.ItemConfig[entities.PostgresqlItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 	return config.DBUsers, nil
 }
 
@@ -196,7 +203,11 @@ func (o *PostgresqlOrder) GetPostgresqlVmItems() ([]entities.Item, error) {
 
 	var postgresqlVmItems []entities.Item
 	for _, vm := range vmItems {
-		nodeRoles := vm.Data.Config.(entities.VMItemConfig).NodeRoles
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vm)
+		if err != nil {
+			return nil, err
+		}
+		nodeRoles := vmConfig.NodeRoles
 		for _, role := range nodeRoles {
 			if role == "postgresql" {
 				postgresqlVmItems = append(postgresqlVmItems, vm)
@@ -240,7 +251,11 @@ func (o *PostgresqlOrder) GetExtraMount(path string) (*entities.ExtraMount, erro
 		vmItem = vmItems[0]
 	}
 
-	extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	if len(extraMounts) == 0 {
 		return nil, errors.New("list of extra mount is empty")
 	}
@@ -269,7 +284,10 @@ func (o *PostgresqlOrder) CreateDb(name, adminPass string, dbEncoding *string, c
 		return err
 	}
 
-	itemConfig := item.Data.Config.(entities.PostgresqlItemConfig)
+	itemConfig, err := entities.ItemConfig[entities.PostgresqlItemConfig](item)
+	if err != nil {
+		return err
+	}
 	envType := strings.ToLower(itemConfig.EnvironmentType)
 	env := strings.ToLower(itemConfig.Environment)
 
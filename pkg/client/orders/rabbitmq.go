@@ -109,7 +109,10 @@ func (o *RabbitMQ) GetRabbitMQNode() (*entities.Item, error) {
 		return nil, err
 	}
 	for i, vm := range VMs {
-		vmConfig := VMs[i].Data.Config.(entities.VMItemConfig)
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&VMs[i])
+		if err != nil {
+			return nil, err
+		}
 		if slices.Contains(vmConfig.NodeRoles, "rabbitmq") {
 			return &vm, nil
 		}
@@ -14,6 +14,7 @@ const rabbitClusterOrderID = "8044a08e-7d26-496b-87c9-ba39975676a1"
 // test should running with increased timeout
 // go test -run TestRabbitMQOrder -timeout 9999s -short
 func TestRabbitMQOrder(t *testing.T) {
+	test.RequireCreds(t)
 
 	// layout, err := references.GetGeoDistributionLayoutID(
 	// 	test.SharedCreds,
@@ -142,7 +143,6 @@ func TestRabbitMQOrder(t *testing.T) {
 }
 
 // func TestGetRabbitMQOrder(t *testing.T) {
-
 // 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-h1eu89sx40", rabbitClusterOrderID)
 // 	if err != nil {
 // 		log.Fatalln(err)
@@ -151,6 +151,7 @@ func TestRabbitMQOrder(t *testing.T) {
 // }
 
 func TestAddVhost(t *testing.T) {
+	test.RequireCreds(t)
 
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-h1eu89sx40", rabbitClusterOrderID)
 	if err != nil {
@@ -167,6 +168,7 @@ func TestAddVhost(t *testing.T) {
 }
 
 func TestUpdateVhostAccess(t *testing.T) {
+	test.RequireCreds(t)
 
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-h1eu89sx40", rabbitClusterOrderID)
 	if err != nil {
@@ -188,6 +190,8 @@ func TestUpdateVhostAccess(t *testing.T) {
 }
 
 func TestDeleteVhostAccess(t *testing.T) {
+	test.RequireCreds(t)
+
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", rabbitClusterOrderID)
 	if err != nil {
 		log.Fatalln(err)
@@ -205,6 +209,7 @@ func TestDeleteVhostAccess(t *testing.T) {
 }
 
 func TestUpdateVhostAccessMultiply(t *testing.T) {
+	test.RequireCreds(t)
 
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", rabbitClusterOrderID)
 	if err != nil {
@@ -226,6 +231,7 @@ func TestUpdateVhostAccessMultiply(t *testing.T) {
 }
 
 func TestVerticalScale(t *testing.T) {
+	test.RequireCreds(t)
 
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", rabbitClusterOrderID)
 	if err != nil {
@@ -265,6 +271,8 @@ func TestVerticalScale(t *testing.T) {
 }
 
 func TestHorizontalScale(t *testing.T) {
+	test.RequireCreds(t)
+
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", rabbitClusterOrderID)
 	if err != nil {
 		log.Fatalln(err)
@@ -282,6 +290,8 @@ func TestHorizontalScale(t *testing.T) {
 }
 
 func TestUpdateRabbitMQVersion(t *testing.T) {
+	test.RequireCreds(t)
+
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", rabbitClusterOrderID)
 	if err != nil {
 		log.Fatalln(err)
@@ -300,6 +310,8 @@ func TestUpdateRabbitMQVersion(t *testing.T) {
 }
 
 func TestGetRabbitMQOrder(t *testing.T) {
+	test.RequireCreds(t)
+
 	orderID := "5b8a153d-d45d-44d7-8d12-c2b107550875"
 	projecName := "proj-e73127g7ry3p4t4"
 	order, err := GetRabbitMQOrder(test.SharedCreds, projecName, orderID)
@@ -317,6 +329,8 @@ func TestGetRabbitMQOrder(t *testing.T) {
 }
 
 func TestChangeFinancialProject(t *testing.T) {
+	test.RequireCreds(t)
+
 	order, err := GetRabbitMQOrder(test.SharedCreds, "proj-e73127g7ry3p4t4", rabbitClusterOrderID)
 	if err != nil {
 		log.Fatal(err)
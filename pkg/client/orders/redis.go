@@ -104,7 +104,10 @@ func (o *Redis) GetUsers() ([]entities.RedisUser, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.RedisItemConfig)
+	config, err := entities.ItemConfig[entities.RedisItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 
 	return config.Users, nil
 }
@@ -308,7 +311,11 @@ func (o *Redis) GetExtraMount(path string) (*entities.ExtraMount, error) {
 		return nil, errors.New("VMs len count must be equal 2")
 	}
 
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	if len(extraMounts) == 0 {
 		return nil, errors.New("length should be more then 0")
 	}
@@ -396,81 +403,11 @@ func (o *Redis) ExpandMountPoint(mountPoint entities.ExtraMount, async bool) (er
 }
 
 func (o *Redis) Stop(async bool) error {
-
-	if err := o.requiredState("on"); err != nil {
-		return err
-	}
-
-	itemID, err := o.GetParentItemID()
-	if err != nil {
-		return err
-	}
-
-	attrs := map[string]interface{}{}
-
-	data := map[string]interface{}{
-		"item_id": itemID,
-		"order": map[string]interface{}{
-			"attrs": o.addCreatedWithOpenTofuTagToAttrs(attrs),
-		},
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	uri := o.generateOrderdActionUri("stop_two_layer")
-	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
-	if err != nil {
-		return err
-	}
-
-	if !async {
-		err = o.WaitSuccess(10)
-		if err != nil {
-			return err
-		}
-	}
-	return err
+	return o.StopTwoLayer(async)
 }
 
 func (o *Redis) Start(async bool) error {
-
-	if err := o.requiredState("off"); err != nil {
-		return err
-	}
-
-	itemID, err := o.GetParentItemID()
-	if err != nil {
-		return err
-	}
-
-	attrs := map[string]interface{}{}
-
-	data := map[string]interface{}{
-		"item_id": itemID,
-		"order": map[string]interface{}{
-			"attrs": o.addCreatedWithOpenTofuTagToAttrs(attrs),
-		},
-	}
-	payload, err := json.Marshal(data)
-	if err != nil {
-		return err
-	}
-
-	uri := o.generateOrderdActionUri("start_two_layer")
-	_, err = requests.SendRequest(o.Creds.AccessToken, uri, "PATCH", payload, nil)
-	if err != nil {
-		return err
-	}
-
-	if !async {
-		err = o.WaitSuccess(10)
-		if err != nil {
-			return err
-		}
-	}
-	return err
+	return o.StartTwoLayer(async)
 }
 
 func (o *Redis) ChangeAccessGroupForVm(vmItemId string, changeAD entities.ADLogonGrants) error {
@@ -121,7 +121,11 @@ func (o *RedisSentinel) GetRedisSentinelVmItems() ([]entities.Item, error) {
 
 	var RedisSentinelVmItems []entities.Item
 	for _, vm := range vmItems {
-		nodeRoles := vm.Data.Config.(entities.VMItemConfig).NodeRoles
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vm)
+		if err != nil {
+			return nil, err
+		}
+		nodeRoles := vmConfig.NodeRoles
 		for _, role := range nodeRoles {
 			if role == "redis" {
 				RedisSentinelVmItems = append(RedisSentinelVmItems, vm)
@@ -142,7 +146,10 @@ func (o *RedisSentinel) GetUsers() ([]entities.RedisSentinelUser, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.RedisSentinelItemConfig)
+	config, err := entities.ItemConfig[entities.RedisSentinelItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 
 	return config.Users, nil
 }
@@ -300,7 +307,11 @@ func (o *RedisSentinel) GetExtraMounts() (extraMounts []entities.ExtraMount, err
 		return nil, err
 	}
 
-	vmExtraMount := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	vmExtraMount := vmConfig.ExtraMounts
 
 	for _, extraMount := range vmExtraMount {
 		formatedExtraMount, err := o.GetExtraMount(extraMount.Mount)
@@ -321,7 +332,11 @@ func (o *RedisSentinel) GetExtraMount(path string) (*entities.ExtraMount, error)
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, errors.New("list of extra mounts is empty")
 		}
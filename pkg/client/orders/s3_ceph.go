@@ -139,7 +139,11 @@ func (o *S3CephOrder) GetBucket(Name string) (*entities.Item, error) {
 	}
 
 	for _, item := range bucketItems {
-		if item.Data.Config.(entities.S3CephBucketItemConfig).Name == Name {
+		config, err := entities.ItemConfig[entities.S3CephBucketItemConfig](&item)
+		if err != nil {
+			return nil, err
+		}
+		if config.Name == Name {
 			return &item, nil
 		}
 	}
@@ -153,7 +157,10 @@ func (o *S3CephOrder) GetTenantUsers() ([]entities.S3CephTenantUsers, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.S3CephTenantItemConfig)
+	config, err := entities.ItemConfig[entities.S3CephTenantItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 	return config.Users, nil
 }
 
@@ -132,7 +132,11 @@ func (o *ScyllaDbCluster) GetScyllaDbClusterVmItems() ([]entities.Item, error) {
 
 	var ScyllaDbClusterVmItems []entities.Item
 	for _, vm := range vmItems {
-		nodeRoles := vm.Data.Config.(entities.VMItemConfig).NodeRoles
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vm)
+		if err != nil {
+			return nil, err
+		}
+		nodeRoles := vmConfig.NodeRoles
 		for _, role := range nodeRoles {
 			if role == "scylladb" {
 				ScyllaDbClusterVmItems = append(ScyllaDbClusterVmItems, vm)
@@ -198,7 +202,11 @@ func (o *ScyllaDbCluster) GetExtraMounts() (extraMounts []entities.ExtraMount, e
 		return nil, err
 	}
 
-	vmExtraMount := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	vmExtraMount := vmConfig.ExtraMounts
 
 	for _, extraMount := range vmExtraMount {
 		formatedExtraMount, err := o.GetExtraMount(extraMount.Mount)
@@ -219,7 +227,11 @@ func (o *ScyllaDbCluster) GetExtraMount(path string) (*entities.ExtraMount, erro
 	var size int64
 	var fileSystem string
 	for _, vmItem := range vmItems {
-		extraMounts := vmItem.Data.Config.(entities.VMItemConfig).ExtraMounts
+		vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItem)
+		if err != nil {
+			return nil, err
+		}
+		extraMounts := vmConfig.ExtraMounts
 		if len(extraMounts) == 0 {
 			return nil, errors.New("list of extra mounts is empty")
 		}
@@ -553,7 +565,10 @@ func (o *ScyllaDbCluster) GetUsers() ([]entities.ScyllaDbusers, error) {
 		return nil, err
 	}
 
-	config := item.Data.Config.(entities.ScyllaDbClusterItemConfig)
+	config, err := entities.ItemConfig[entities.ScyllaDbClusterItemConfig](item)
+	if err != nil {
+		return nil, err
+	}
 	return config.DbUsers, nil
 }
 
@@ -658,7 +673,11 @@ func (o *ScyllaDbCluster) GetDbPermissions() (dbPermissions []string, err error)
 		return
 	}
 
-	dbPerms := parentItem.Data.Config.(entities.ScyllaDbClusterItemConfig).Permissions
+	config, err := entities.ItemConfig[entities.ScyllaDbClusterItemConfig](parentItem)
+	if err != nil {
+		return nil, err
+	}
+	dbPerms := config.Permissions
 
 	for _, dbPerm := range dbPerms {
 		dbPermissions = append(dbPermissions, dbPerm.UserName+":"+dbPerm.DbName)
@@ -239,7 +239,11 @@ func (o *SyncXpertCluster) GetExtraMount(path string) (*entities.ExtraMount, err
 		return nil, err
 	}
 
-	extraMounts := vmItems[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&vmItems[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 	if len(extraMounts) == 0 {
 		return nil, nil
 	}
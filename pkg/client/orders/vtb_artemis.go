@@ -287,7 +287,11 @@ func (o *ArtemisOrder) GetExtraMount(path string) (*entities.ExtraMount, error)
 		return nil, err
 	}
 
-	extraMounts := items[0].Data.Config.(entities.VMItemConfig).ExtraMounts
+	vmConfig, err := entities.ItemConfig[entities.VMItemConfig](&items[0])
+	if err != nil {
+		return nil, err
+	}
+	extraMounts := vmConfig.ExtraMounts
 
 	for _, em := range extraMounts {
 		if em.Mount == path {
@@ -7,6 +7,8 @@ import (
 )
 
 func TestGetProductImageData(t *testing.T) {
+	test.UseCassette(t)
+
 	product, err := GetProductImageData(test.SharedCreds, "rqaas", "dev")
 	if err != nil {
 		log.Fatalf("Can't fetch from product-catalog, error: %v", err.Error())
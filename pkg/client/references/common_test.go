@@ -9,6 +9,8 @@ import (
 )
 
 func TestGetImageID(t *testing.T) {
+	test.RequireCreds(t)
+
 	img := "tpl_linux_astra_1.7_x86_64_en_20230916"
 	imageID, err := GetImageID(*test.SharedCreds, img)
 	if err != nil {
@@ -18,6 +20,8 @@ func TestGetImageID(t *testing.T) {
 }
 
 func TestGetFlavor(t *testing.T) {
+	test.RequireCreds(t)
+
 	cores := 4
 	memory := 4
 	// filter := "flavor:vm:linux:dev"
@@ -35,6 +39,8 @@ func TestGetFlavor(t *testing.T) {
 }
 
 func TestGetImageOsVersion(t *testing.T) {
+	test.RequireCreds(t)
+
 	osVersion, err := GetImageOsVersion(*test.SharedCreds, "astra", "1.7")
 	if err != nil {
 		log.Fatalf("Can't fetch imageOsVersion from reference service: %s", err.Error())
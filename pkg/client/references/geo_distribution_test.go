@@ -7,13 +7,15 @@ import (
 )
 
 func TestGetGeoDistibutionLayoutNameByID(t *testing.T) {
+	test.UseCassette(t)
+
 	product := "artemis"
 	layout_id := "ec8dacb8-4750-41ec-8d51-46137b2b518d"
 	org := "vtb"
 	net_segment := "dev-srv-app"
 
 	layoutName, err := GetGeoDistributionLayoutNameByID(
-		test.SharedCreds, product, layout_id, org, net_segment,
+		test.SharedCreds, layout_id, product, org, net_segment,
 	)
 	if err != nil {
 		log.Fatalf("Can't fetch layout name from reference service: %s", err.Error())
@@ -7,6 +7,8 @@ import (
 )
 
 func TestGetRabbitMQLatestReleaseVersion(t *testing.T) {
+	test.RequireCreds(t)
+
 	version := "1.7"
 	distribution := "astra"
 	org := "vtb"
@@ -22,6 +24,8 @@ func TestGetRabbitMQLatestReleaseVersion(t *testing.T) {
 }
 
 func TestGetTarantoolDataGridImageData(t *testing.T) {
+	test.RequireCreds(t)
+
 	version := "1.7"
 	distribution := "astra"
 	org := "vtb"
@@ -13,6 +13,14 @@ import (
 	"time"
 )
 
+// PortalScheme схема запросов к API и авторизации портала
+var PortalScheme = "https"
+
+// Transport транспорт HTTP клиентов портала, nil - http.DefaultTransport.
+// В тестах подменяется кассетой, см. пакет cassette
+var Transport http.RoundTripper
+
+// PortalAPI и PortalAuthURL адреса портала без схемы: хост и необязательный префикс пути
 var PortalAPI, PortalAuthURL, PortalConsoleUrl string = func() (apiUrl, authUrl, consoleUrl string) {
 	switch os.Getenv("PORTAL_STAND") {
 	case "blue":
@@ -34,6 +42,56 @@ var PortalAPI, PortalAuthURL, PortalConsoleUrl string = func() (apiUrl, authUrl,
 	}
 }()
 
+// envURLErr ошибка разбора PORTAL_API_URL и PORTAL_AUTH_URL, см. EnvURLError
+var envURLErr error
+
+func init() {
+	// PORTAL_API_URL и PORTAL_AUTH_URL переопределяют стенд, например для локального стенда
+	apiURL, authURL := os.Getenv("PORTAL_API_URL"), os.Getenv("PORTAL_AUTH_URL")
+	if apiURL == "" {
+		return
+	}
+	if authURL == "" {
+		authURL = apiURL
+	}
+	envURLErr = SetPortalURL(apiURL, authURL)
+}
+
+// EnvURLError ошибка в адресах портала из PORTAL_API_URL и PORTAL_AUTH_URL.
+// При ошибке запросы уходят на стенд по умолчанию, поэтому вызывающий должен ее проверить
+func EnvURLError() error {
+	return envURLErr
+}
+
+// SetPortalURL направляет запросы клиента на указанные адреса API и авторизации портала.
+// Адреса задаются вместе со схемой и могут содержать префикс пути:
+// https://api.cloud.vtb.ru, http://127.0.0.1:8080/portal
+func SetPortalURL(apiURL, authURL string) error {
+
+	api, err := url.Parse(apiURL)
+	if err != nil {
+		return fmt.Errorf("invalid portal api url '%s': %w", apiURL, err)
+	}
+	auth, err := url.Parse(authURL)
+	if err != nil {
+		return fmt.Errorf("invalid portal auth url '%s': %w", authURL, err)
+	}
+	if api.Host == "" || auth.Host == "" {
+		return fmt.Errorf("portal urls must contain scheme and host, got '%s' and '%s'", apiURL, authURL)
+	}
+	if api.Scheme != auth.Scheme {
+		return fmt.Errorf("portal api and auth urls must have the same scheme, got '%s' and '%s'", apiURL, authURL)
+	}
+	if api.RawQuery != "" || api.Fragment != "" || auth.RawQuery != "" || auth.Fragment != "" {
+		return fmt.Errorf("portal urls mustn't contain query or fragment, got '%s' and '%s'", apiURL, authURL)
+	}
+
+	PortalScheme = api.Scheme
+	PortalAPI = api.Host + strings.TrimSuffix(api.EscapedPath(), "/")
+	PortalAuthURL = auth.Host + strings.TrimSuffix(auth.EscapedPath(), "/")
+	return nil
+}
+
 func SendRequest(
 	AccessToken,
 	uri,
@@ -52,14 +110,15 @@ func SendRequest(
 	encodedData := data.Encode()
 
 	// prepare url
-	url := fmt.Sprintf("https://%s/%s", PortalAPI, uri)
+	url := fmt.Sprintf("%s://%s/%s", PortalScheme, PortalAPI, strings.TrimPrefix(uri, "/"))
 	if len(parameters) > 0 {
 		url = url + "?" + encodedData
 	}
 
 	// prepare client
 	client := http.Client{
-		Timeout: 60 * time.Second,
+		Timeout:   60 * time.Second,
+		Transport: Transport,
 	}
 	request, err := http.NewRequest(method, url, bytes.NewBuffer(payload))
 	if err != nil {
@@ -119,13 +178,14 @@ func SendAuthRequest(clientID, secret string, isNew bool) (*http.Response, error
 
 	// prepare url
 	url := fmt.Sprintf(
-		"https://%s/auth/realms/Portal/protocol/openid-connect/token",
-		PortalAuthURL,
+		"%s://%s/auth/realms/Portal/protocol/openid-connect/token",
+		PortalScheme, PortalAuthURL,
 	)
 
 	// prepare client
 	client := http.Client{
-		Timeout: 30 * time.Second,
+		Timeout:   30 * time.Second,
+		Transport: Transport,
 	}
 	request, err := http.NewRequest("POST", url, body)
 	if err != nil {
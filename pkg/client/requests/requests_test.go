@@ -0,0 +1,109 @@
+package requests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func restorePortalURL(t *testing.T) {
+	scheme, api, auth := PortalScheme, PortalAPI, PortalAuthURL
+	t.Cleanup(func() {
+		PortalScheme, PortalAPI, PortalAuthURL = scheme, api, auth
+	})
+}
+
+func TestSetPortalURL(t *testing.T) {
+	cases := []struct {
+		name       string
+		apiURL     string
+		authURL    string
+		wantScheme string
+		wantAPI    string
+		wantAuth   string
+		wantErr    bool
+	}{
+		{
+			name:       "host only",
+			apiURL:     "https://api.cloud.vtb.ru",
+			authURL:    "https://auth.cloud.vtb.ru/",
+			wantScheme: "https",
+			wantAPI:    "api.cloud.vtb.ru",
+			wantAuth:   "auth.cloud.vtb.ru",
+		},
+		{
+			name:       "path prefix is kept",
+			apiURL:     "http://127.0.0.1:8080/portal/api/",
+			authURL:    "http://127.0.0.1:8080/keycloak",
+			wantScheme: "http",
+			wantAPI:    "127.0.0.1:8080/portal/api",
+			wantAuth:   "127.0.0.1:8080/keycloak",
+		},
+		{name: "without scheme", apiURL: "api.cloud.vtb.ru", authURL: "api.cloud.vtb.ru", wantErr: true},
+		{name: "different schemes", apiURL: "http://127.0.0.1", authURL: "https://127.0.0.1", wantErr: true},
+		{name: "query", apiURL: "http://127.0.0.1/?stand=dev", authURL: "http://127.0.0.1", wantErr: true},
+		{name: "malformed", apiURL: "http://[::1", authURL: "http://127.0.0.1", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			restorePortalURL(t)
+			PortalScheme, PortalAPI, PortalAuthURL = "https", "api.cloud.vtb.ru", "auth.cloud.vtb.ru"
+
+			err := SetPortalURL(c.apiURL, c.authURL)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("Expected error, got %s://%s and %s", PortalScheme, PortalAPI, PortalAuthURL)
+				}
+				if PortalScheme != "https" || PortalAPI != "api.cloud.vtb.ru" || PortalAuthURL != "auth.cloud.vtb.ru" {
+					t.Fatalf("Portal urls mustn't change on error, got %s://%s and %s", PortalScheme, PortalAPI, PortalAuthURL)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if PortalScheme != c.wantScheme || PortalAPI != c.wantAPI || PortalAuthURL != c.wantAuth {
+				t.Fatalf(
+					"Expected %s://%s and %s, got %s://%s and %s",
+					c.wantScheme, c.wantAPI, c.wantAuth, PortalScheme, PortalAPI, PortalAuthURL,
+				)
+			}
+		})
+	}
+}
+
+func TestSendRequestWithPathPrefix(t *testing.T) {
+	restorePortalURL(t)
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Write([]byte(`{"access_token": "token"}`))
+	}))
+	defer server.Close()
+
+	if err := SetPortalURL(server.URL+"/portal", server.URL+"/keycloak/"); err != nil {
+		t.Fatalf("Set portal url: %v", err)
+	}
+
+	resp, err := SendAuthRequest("client-id", "secret", true)
+	if err != nil {
+		t.Fatalf("Send auth request: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = SendRequest("token", "/order-service/api/v1/projects/proj/orders", "GET", nil, nil)
+	if err != nil {
+		t.Fatalf("Send request: %v", err)
+	}
+	resp.Body.Close()
+
+	expected := []string{
+		"/keycloak/auth/realms/Portal/protocol/openid-connect/token",
+		"/portal/order-service/api/v1/projects/proj/orders",
+	}
+	if len(paths) != len(expected) || paths[0] != expected[0] || paths[1] != expected[1] {
+		t.Fatalf("Expected requests to %v, got %v", expected, paths)
+	}
+}
@@ -10,6 +10,7 @@ import (
 const PROJECT_NAME = "proj-e73127g7ry3p4t4"
 
 func TestGetProcjet(t *testing.T) {
+	test.RequireCreds(t)
 
 	project, err := GetProject(test.SharedCreds, PROJECT_NAME)
 	if err != nil {
@@ -19,6 +20,8 @@ func TestGetProcjet(t *testing.T) {
 }
 
 func TestGetFinancialProject(t *testing.T) {
+	test.RequireCreds(t)
+
 	finProj, err := GetFinancialProjectByName(test.SharedCreds, PROJECT_NAME, "Cервис подключения к BaaS")
 	if err != nil {
 		log.Fatal(err)
@@ -8,6 +8,8 @@ import (
 )
 
 func TestGetAvailabilityZones(t *testing.T) {
+	test.RequireCreds(t)
+
 	zones, err := GetAvailAbilityZones(test.SharedCreds, "dev-srv-app", "vtb")
 	if err != nil {
 		log.Fatal(err)
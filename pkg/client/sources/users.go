@@ -2,6 +2,7 @@ package sources
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
@@ -18,6 +19,9 @@ type User struct {
 	UniqueName string `json:"unique_name"`
 }
 
+// ErrUserNotFound возвращается, когда по точному запросу (email или username@domain) пользователь не найден
+var ErrUserNotFound = errors.New("user not found")
+
 type Purpose struct {
 	ID          string `json:"id"`
 	CreatedAt   string `json:"created_at"`
@@ -95,7 +99,7 @@ func GetUsersByQuery(creds *auth.Credentials, queryString, projectName, domain s
 				return []User{u}, nil
 			}
 		}
-		return nil, fmt.Errorf("can't find user by query string: '%s'", queryString)
+		return nil, fmt.Errorf("can't find user by query string: '%s': %w", queryString, ErrUserNotFound)
 	}
 	return
 }
@@ -181,7 +185,7 @@ func FindUsersByGroupAndQuery(
 				return []User{u}, nil
 			}
 		}
-		return nil, fmt.Errorf("can't find user by query string: '%s'", queryString)
+		return nil, fmt.Errorf("can't find user by query string: '%s': %w", queryString, ErrUserNotFound)
 	}
 	return
 }
@@ -1,21 +1,36 @@
 package test
 
 import (
+	"errors"
+	"io/fs"
 	"log"
+	"net/http"
+	"testing"
 
 	"terraform-provider-vtb/pkg/client/auth"
+	"terraform-provider-vtb/pkg/client/cassette"
 	"terraform-provider-vtb/pkg/client/env"
+	"terraform-provider-vtb/pkg/client/requests"
 )
 
 // SharedCreds is used only for testing purposes
 var SharedCreds *auth.Credentials
 
+// hasCreds учетные данные сервисного аккаунта заданы и тесты работают с порталом
+var hasCreds bool
+
 func init() {
 
 	serviceAcc := env.Load()
 
 	if serviceAcc.ProjectName == "" || serviceAcc.ClientID == "" || serviceAcc.ClientSecret == "" {
-		log.Fatalf("Service Account Data has empty value: %v", serviceAcc)
+		if cassette.ModeFromEnv() == cassette.Record {
+			log.Fatalf("Service Account Data has empty value: %v", serviceAcc)
+		}
+		// без учетных данных работают только тесты с кассетами,
+		// остальные пропускаются через RequireCreds
+		log.Printf("Service Account Data is empty, requests are replayed from cassettes")
+		SharedCreds = &auth.Credentials{AccessToken: "replayed-access-token"}
 		return
 	}
 
@@ -28,4 +43,49 @@ func init() {
 		log.Fatalf("Service Account initializtion error: %s", err.Error())
 	}
 	SharedCreds = newCreds
+	hasCreds = true
+}
+
+// RequireCreds пропускает тест, которому нужен портал, если учетные данные не заданы
+func RequireCreds(t *testing.T) {
+	t.Helper()
+
+	if !hasCreds {
+		t.Skip("Service Account Data is empty, test requires portal")
+	}
+}
+
+// UseCassette направляет запросы клиента в тесте через кассету testdata/cassettes/<имя теста>.yaml.
+// С VTB_RECORD=1 кассета записывается заново. С учетными данными тест работает с порталом
+// без кассеты, иначе ответы воспроизводятся из нее, а тест без записанной кассеты пропускается
+func UseCassette(t *testing.T) {
+	t.Helper()
+
+	mode := cassette.ModeFromEnv()
+	if mode == cassette.Replay && hasCreds {
+		return
+	}
+
+	path := cassette.Path(t.Name())
+	c, err := cassette.Load(path, mode)
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Skipf("Cassette '%s' isn't recorded, run test with VTB_RECORD=1", path)
+	}
+	if err != nil {
+		t.Fatalf("Can't load cassette: %v", err)
+	}
+	useTransport(t, c)
+	t.Cleanup(func() {
+		if err := c.Save(); err != nil {
+			t.Errorf("Can't save cassette '%s': %v", path, err)
+		}
+	})
+}
+
+func useTransport(t *testing.T, transport http.RoundTripper) {
+	previous := requests.Transport
+	requests.Transport = transport
+	t.Cleanup(func() {
+		requests.Transport = previous
+	})
 }